@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 const (
@@ -27,32 +28,112 @@ func main() {
 	log.Info("logger setup")
 
 	// TODO: init auth client
+	var verifier *ssogrpc.LocalVerifier
+	if cfg.Clients.SSO.AppSecret != "" || cfg.Clients.SSO.JWKSPath != "" {
+		var err error
+		verifier, err = ssogrpc.NewLocalVerifier(cfg.Clients.SSO.AppSecret, cfg.Clients.SSO.JWKSPath, cfg.Clients.SSO.Leeway)
+		if err != nil {
+			panic(err)
+		}
+	}
+	var tokenCache *ssogrpc.TokenCache
+	if cfg.Clients.SSO.CacheSize > 0 {
+		tokenCache = ssogrpc.NewTokenCache(cfg.Clients.SSO.CacheSize, cfg.Clients.SSO.CacheTTL, cfg.Clients.SSO.NegativeCacheTTL)
+	}
+	var adminCache *ssogrpc.AdminCache
+	if cfg.Clients.SSO.AdminCacheSize > 0 {
+		adminCache = ssogrpc.NewAdminCache(cfg.Clients.SSO.AdminCacheSize, cfg.Clients.SSO.AdminCacheTTL, cfg.Clients.SSO.NegativeCacheTTL)
+	}
 	authClient, err := ssogrpc.New(
 		context.Background(),
 		log,
 		cfg.Clients.SSO.Address,
 		cfg.Clients.SSO.Timeout,
 		cfg.Clients.SSO.RetriesCount,
+		verifier,
+		tokenCache,
+		adminCache,
 	)
 
 	if err != nil {
 		panic(err)
 	}
 	authClientInterceptor := ssogrpc.SSOMiddleware(authClient, cfg.Clients.SSO.AppID)
+	authClientStreamInterceptor := ssogrpc.SSOStreamMiddleware(authClient, cfg.Clients.SSO.AppID)
 
 	// TODO: INIT APP
-	application := app.New(log, cfg.GRPC.Port, cfg.StoragePath, authClientInterceptor)
+	application := app.New(
+		log,
+		cfg.GRPC.Port,
+		cfg.StoragePath,
+		cfg.Search.FuzzyThreshold,
+		cfg.Phone.DefaultRegion,
+		cfg.Trash.Retention,
+		cfg.Sync.TombstoneRetention,
+		cfg.Trash.PurgeInterval,
+		cfg.Watch.HistorySize,
+		authClientInterceptor,
+		authClientStreamInterceptor,
+	)
 	go application.GRPCSrv.MustRun()
+	go application.Purger.Run()
+	stopStats := make(chan struct{})
+	go reportCacheStats(log, cfg.Clients.SSO.CacheStatsInterval, tokenCache, adminCache, stopStats)
 
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
 
 	<-stop
 
+	// Open watches would keep graceful stop waiting
+	application.Events.Close()
 	application.GRPCSrv.Stop()
+	application.Purger.Stop()
+	close(stopStats)
+	logCacheStats(log, tokenCache, adminCache)
 	log.Info("application stopped")
 }
 
+// Logs stats of the caches every interval until stop is closed
+func reportCacheStats(
+	log *slog.Logger,
+	interval time.Duration,
+	tokenCache *ssogrpc.TokenCache,
+	adminCache *ssogrpc.AdminCache,
+	stop <-chan struct{},
+) {
+	if interval <= 0 || (tokenCache == nil && adminCache == nil) {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			logCacheStats(log, tokenCache, adminCache)
+		case <-stop:
+			return
+		}
+	}
+}
+
+func logCacheStats(log *slog.Logger, tokenCache *ssogrpc.TokenCache, adminCache *ssogrpc.AdminCache) {
+	if tokenCache != nil {
+		logStats(log, "token cache stats", tokenCache.Stats())
+	}
+	if adminCache != nil {
+		logStats(log, "admin cache stats", adminCache.Stats())
+	}
+}
+
+func logStats(log *slog.Logger, msg string, stats ssogrpc.CacheStats) {
+	log.Info(msg,
+		slog.Uint64("hits", stats.Hits),
+		slog.Uint64("misses", stats.Misses),
+		slog.Int("size", stats.Size),
+	)
+}
+
 func setupLogger(env string) *slog.Logger {
 	var log *slog.Logger
 
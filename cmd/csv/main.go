@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/config"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	cmgrpc "gRPC_ContactManagement_Service/internal/grpc/cm"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"gRPC_ContactManagement_Service/internal/lib/eventbus"
+	"gRPC_ContactManagement_Service/internal/lib/phonenum"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	"gRPC_ContactManagement_Service/internal/storage/sqlite"
+	"github.com/ilyakaznacheev/cleanenv"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+var profiles = map[string]cmv1.CSVProfile{
+	"default": cmv1.CSVProfile_CSV_PROFILE_UNSPECIFIED,
+	"google":  cmv1.CSVProfile_CSV_PROFILE_GOOGLE,
+	"outlook": cmv1.CSVProfile_CSV_PROFILE_OUTLOOK,
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	var configPath, storagePath, email, importPath, exportPath, profileName, mappingPath, phoneRegion, tag string
+	var userID, groupID int64
+	var fuzzyThreshold float64
+	var dryRun bool
+	flag.StringVar(&configPath, "config", os.Getenv("CONFIG_PATH"), "path to config file of the service, its settings are used unless flags override them")
+	flag.StringVar(&storagePath, "storage-path", "", "path to storage")
+	flag.Int64Var(&userID, "user-id", 0, "SSO id of the address book owner")
+	flag.StringVar(&email, "email", "", "current email of the address book owner")
+	flag.StringVar(&importPath, "import", "", "path to CSV file to import")
+	flag.StringVar(&exportPath, "export", "", "path to CSV file to export to")
+	flag.StringVar(&profileName, "profile", "default", "columns profile: default, google or outlook")
+	flag.StringVar(&mappingPath, "mapping", "", "path to JSON file with custom CSVMapping, overrides profile")
+	flag.BoolVar(&dryRun, "dry-run", false, "only validate imported rows")
+	flag.StringVar(&phoneRegion, "phone-region", "", "region of phone numbers without country code, phone.default_region of config by default")
+	flag.Float64Var(&fuzzyThreshold, "fuzzy-threshold", 0, "minimal similarity of names, search.fuzzy_threshold of config by default")
+	flag.Int64Var(&groupID, "group-id", 0, "export only contacts of the group")
+	flag.StringVar(&tag, "tag", "", "export only contacts with the tag")
+
+	flag.Parse()
+
+	// settings of the service apply when the flags are not set
+	var search config.SearchConfig
+	var phone config.PhoneConfig
+	if configPath != "" {
+		cfg, err := config.LoadByPath(configPath)
+		if err != nil {
+			return err
+		}
+		search, phone = cfg.Search, cfg.Phone
+		if storagePath == "" {
+			storagePath = cfg.StoragePath
+		}
+	} else {
+		if err := cleanenv.ReadEnv(&search); err != nil {
+			return err
+		}
+		if err := cleanenv.ReadEnv(&phone); err != nil {
+			return err
+		}
+	}
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "phone-region":
+			phone.DefaultRegion = phoneRegion
+		case "fuzzy-threshold":
+			search.FuzzyThreshold = fuzzyThreshold
+		}
+	})
+
+	phone.DefaultRegion = strings.ToUpper(phone.DefaultRegion)
+	if !phonenum.IsSupportedRegion(phone.DefaultRegion) {
+		return errors.New("unsupported phone region " + phone.DefaultRegion)
+	}
+	if storagePath == "" {
+		return errors.New("storage-path is required")
+	}
+	if userID <= 0 {
+		return errors.New("user-id is required")
+	}
+	if email == "" {
+		return errors.New("email is required")
+	}
+	if (importPath == "") == (exportPath == "") {
+		return errors.New("exactly one of import and export is required")
+	}
+	profile, ok := profiles[profileName]
+	if !ok {
+		return errors.New("unknown profile " + profileName)
+	}
+	var mapping *cmv1.CSVMapping
+	if mappingPath != "" {
+		data, err := os.ReadFile(mappingPath)
+		if err != nil {
+			return err
+		}
+		mapping = &cmv1.CSVMapping{}
+		if err = protojson.Unmarshal(data, mapping); err != nil {
+			return fmt.Errorf("invalid mapping: %w", err)
+		}
+	}
+
+	storage, err := sqlite.New(storagePath)
+	if err != nil {
+		return err
+	}
+	log := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	cmService := cm.New(log, storage, eventbus.New[models.ContactEvent](1), search.FuzzyThreshold)
+	user := auth.Principal{UserID: userID, Email: email}
+	ctx := auth.WithPrincipal(context.Background(), user)
+	ownerKey, err := cmService.RegisterUser(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	if exportPath != "" {
+		f, err := os.Create(exportPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		filter := models.ContactFilter{GroupID: groupID, Tag: strings.TrimSpace(tag)}
+		if err = cmgrpc.ExportCSV(ctx, cmService, ownerKey, f, profile, mapping, filter); err != nil {
+			return errors.New(status.Convert(err).Message())
+		}
+		if err = f.Close(); err != nil {
+			return err
+		}
+		fmt.Println("contacts exported")
+		return nil
+	}
+
+	f, err := os.Open(importPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	resp, err := cmgrpc.ImportCSV(ctx, cmService, ownerKey, f, profile, mapping, dryRun, phone.DefaultRegion)
+	if err != nil {
+		return errors.New(status.Convert(err).Message())
+	}
+	for _, r := range resp.GetResults() {
+		if r.GetError() != "" {
+			fmt.Printf("row %d (%s): %s\n", r.GetIndex()+1, r.GetName(), r.GetError())
+		}
+	}
+	if dryRun {
+		fmt.Printf("%d valid rows, %d invalid rows\n", resp.GetImported(), resp.GetFailed())
+		return nil
+	}
+	fmt.Printf("%d contacts imported, %d rows failed\n", resp.GetImported(), resp.GetFailed())
+	return nil
+}
@@ -1,20 +1,35 @@
 package main
 
 import (
+	"database/sql"
+	"encoding/csv"
 	"errors"
 	"flag"
 	"fmt"
+	"gRPC_ContactManagement_Service/internal/lib/translit"
 	"github.com/golang-migrate/migrate/v4"
-	_ "github.com/golang-migrate/migrate/v4/database/sqlite3"
+	"github.com/golang-migrate/migrate/v4/database/sqlite3"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	gosqlite3 "github.com/mattn/go-sqlite3"
+	"io"
+	"os"
+	"strconv"
+	"strings"
 )
 
+// Driver of the connection migrations run on, it has the functions migrations use
+const driverName = "sqlite3_migrator"
+
+// Emails of SSO users exported from SSO, nil if --users is not set
+var ssoUsers map[string]int64
+
 func main() {
-	var storagePath, migrationsPath, migrationsTable string
+	var storagePath, migrationsPath, migrationsTable, usersPath string
 	var isUp bool
 	flag.StringVar(&storagePath, "storage-path", "", "path to storage")
 	flag.StringVar(&migrationsPath, "migrations-path", "", "path to migrations")
 	flag.StringVar(&migrationsTable, "migrations-table", "migrations", "name of migrations table")
+	flag.StringVar(&usersPath, "users", "", "path to CSV file of SSO users (id,email), required to key existing data by user id")
 	flag.BoolVar(&isUp, "up", true, "migrate up or down. up by default")
 
 	flag.Parse()
@@ -25,11 +40,24 @@ func main() {
 	if migrationsPath == "" {
 		panic("migrations-path is required")
 	}
+	if usersPath != "" {
+		users, err := loadUsers(usersPath)
+		if err != nil {
+			panic(err)
+		}
+		ssoUsers = users
+	}
 
-	m, err := migrate.New(
-		"file://"+migrationsPath,
-		fmt.Sprintf("sqlite3://%s?x-migrations-table=%s", storagePath, migrationsTable),
-	)
+	sql.Register(driverName, &gosqlite3.SQLiteDriver{ConnectHook: registerFunctions})
+	db, err := sql.Open(driverName, storagePath)
+	if err != nil {
+		panic(err)
+	}
+	driver, err := sqlite3.WithInstance(db, &sqlite3.Config{MigrationsTable: migrationsTable})
+	if err != nil {
+		panic(err)
+	}
+	m, err := migrate.NewWithDatabaseInstance("file://"+migrationsPath, "sqlite3", driver)
 	if err != nil {
 		panic(err)
 	}
@@ -42,6 +70,7 @@ func main() {
 			panic(err)
 		}
 		fmt.Println("up migrations applied")
+		reportUnknownOwners(db)
 	} else {
 		if err = m.Down(); err != nil {
 			panic(err)
@@ -49,3 +78,81 @@ func main() {
 		fmt.Println("down migrations applied")
 	}
 }
+
+// Functions called by migrations
+func registerFunctions(conn *gosqlite3.SQLiteConn) error {
+	// sso_user_id returns id of the SSO user with the email, NULL if SSO doesn't know the email
+	err := conn.RegisterFunc("sso_user_id", func(email string) (any, error) {
+		if ssoUsers == nil {
+			return nil, errors.New("existing data has to be keyed by SSO user id, run migrator with --users")
+		}
+		id, ok := ssoUsers[strings.ToLower(email)]
+		if !ok {
+			return nil, nil
+		}
+		return id, nil
+	}, true)
+	if err != nil {
+		return err
+	}
+	// translit_key returns romanized search key of a contact name
+	if err = conn.RegisterFunc("translit_key", translit.Key, true); err != nil {
+		return err
+	}
+	// migration_error aborts the migration with the message
+	return conn.RegisterFunc("migration_error", func(msg string) (int, error) {
+		return 0, errors.New(msg)
+	}, false)
+}
+
+// Reads users exported from SSO, one "id,email" line per email. A header line is skipped
+func loadUsers(path string) (map[string]int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+	users := make(map[string]int64)
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		id, err := strconv.ParseInt(record[0], 10, 64)
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("%s:%d: invalid user id %q", path, line, record[0])
+		}
+		email := strings.ToLower(strings.TrimSpace(record[1]))
+		if id <= 0 || email == "" {
+			return nil, fmt.Errorf("%s:%d: invalid user", path, line)
+		}
+		if other, ok := users[email]; ok && other != id {
+			return nil, fmt.Errorf("%s:%d: email %s belongs to users %d and %d", path, line, email, other, id)
+		}
+		users[email] = id
+	}
+	return users, nil
+}
+
+// Prints how many owners of data SSO didn't know, their data stays under their emails
+// and can't be reached by any user
+func reportUnknownOwners(db *sql.DB) {
+	var count int
+	err := db.QueryRow(
+		"SELECT COUNT(*) FROM address_books WHERE personal = 1 AND owner_key NOT LIKE 'user:%'",
+	).Scan(&count)
+	if err != nil || count == 0 {
+		return
+	}
+	fmt.Printf("data of %d emails unknown to SSO is not keyed by user id and is unreachable\n", count)
+}
@@ -2,21 +2,35 @@ package app
 
 import (
 	grpcapp "gRPC_ContactManagement_Service/internal/app/grpc"
+	purgerapp "gRPC_ContactManagement_Service/internal/app/purger"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/eventbus"
 	"gRPC_ContactManagement_Service/internal/service/cm"
 	"gRPC_ContactManagement_Service/internal/storage/sqlite"
 	"google.golang.org/grpc"
 	"log/slog"
+	"time"
 )
 
 type App struct {
 	GRPCSrv *grpcapp.App
+	Purger  *purgerapp.App
+	// Events carries contact changes to watchers
+	Events *eventbus.Bus[models.ContactEvent]
 }
 
 func New(
 	log *slog.Logger,
 	port int,
 	storagePath string,
+	fuzzyThreshold float64,
+	phoneRegion string,
+	trashRetention time.Duration,
+	tombstoneRetention time.Duration,
+	purgeInterval time.Duration,
+	watchHistorySize int,
 	ssoInterceptor grpc.UnaryServerInterceptor,
+	ssoStreamInterceptor grpc.StreamServerInterceptor,
 ) *App {
 	// TODO: init storage
 	storage, err := sqlite.New(storagePath)
@@ -24,8 +38,10 @@ func New(
 		panic(err)
 	}
 	// TODO: init cm service
-	cmService := cm.New(log, storage, storage, storage)
+	events := eventbus.New[models.ContactEvent](watchHistorySize)
+	cmService := cm.New(log, storage, events, fuzzyThreshold)
 
-	grpcApp := grpcapp.New(log, cmService, port, ssoInterceptor)
-	return &App{GRPCSrv: grpcApp}
+	grpcApp := grpcapp.New(log, cmService, port, phoneRegion, ssoInterceptor, ssoStreamInterceptor)
+	purger := purgerapp.New(log, cmService, trashRetention, tombstoneRetention, purgeInterval)
+	return &App{GRPCSrv: grpcApp, Purger: purger, Events: events}
 }
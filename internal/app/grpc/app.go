@@ -2,6 +2,7 @@ package grpcapp
 
 import (
 	"fmt"
+	"gRPC_ContactManagement_Service/internal/grpc/authz"
 	cmgrpc "gRPC_ContactManagement_Service/internal/grpc/cm"
 	"google.golang.org/grpc"
 	"log/slog"
@@ -18,12 +19,21 @@ func New(
 	log *slog.Logger,
 	cm cmgrpc.ContactManager,
 	port int,
+	phoneRegion string,
 	ssoInterceptor grpc.UnaryServerInterceptor,
+	ssoStreamInterceptor grpc.StreamServerInterceptor,
 ) *App {
 	gRPC := grpc.NewServer(
-		grpc.UnaryInterceptor(ssoInterceptor),
+		grpc.ChainUnaryInterceptor(
+			ssoInterceptor,
+			authz.UnaryServerInterceptor(log, cmgrpc.MethodPermissions),
+		),
+		grpc.ChainStreamInterceptor(
+			ssoStreamInterceptor,
+			authz.StreamServerInterceptor(log, cmgrpc.MethodPermissions),
+		),
 	)
-	cmgrpc.Register(gRPC, cm)
+	cmgrpc.Register(gRPC, cm, phoneRegion)
 	return &App{
 		log:        log,
 		gRPCServer: gRPC,
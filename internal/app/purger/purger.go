@@ -0,0 +1,97 @@
+package purgerapp
+
+import (
+	"context"
+	"gRPC_ContactManagement_Service/internal/lib/logger/sl"
+	"log/slog"
+	"time"
+)
+
+type ContactPurger interface {
+	PurgeExpiredContacts(ctx context.Context, retention time.Duration) (int64, error)
+	PurgeExpiredTombstones(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// App periodically removes contacts that have been in trash longer than retention
+// and tombstones of purged contacts older than tombstoneRetention
+type App struct {
+	log                *slog.Logger
+	purger             ContactPurger
+	retention          time.Duration
+	tombstoneRetention time.Duration
+	interval           time.Duration
+	stop               chan struct{}
+	done               chan struct{}
+}
+
+func New(
+	log *slog.Logger,
+	purger ContactPurger,
+	retention time.Duration,
+	tombstoneRetention time.Duration,
+	interval time.Duration,
+) *App {
+	return &App{
+		log:                log,
+		purger:             purger,
+		retention:          retention,
+		tombstoneRetention: tombstoneRetention,
+		interval:           interval,
+		stop:               make(chan struct{}),
+		done:               make(chan struct{}),
+	}
+}
+
+// Run purges trash and expired tombstones right away and then every interval until Stop is called.
+// Zero retention or interval disables purging
+func (a *App) Run() {
+	const op = "purgerapp.Run"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+	defer close(a.done)
+
+	if a.retention <= 0 || a.interval <= 0 {
+		log.Info("trash purging is disabled")
+		return
+	}
+	log.Info("trash purger is running", slog.Duration("retention", a.retention))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-a.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		if _, err := a.purger.PurgeExpiredContacts(ctx, a.retention); err != nil {
+			log.Error("failed to purge trash", sl.Err(err))
+		}
+		if a.tombstoneRetention > 0 {
+			if _, err := a.purger.PurgeExpiredTombstones(ctx, a.tombstoneRetention); err != nil {
+				log.Error("failed to purge tombstones", sl.Err(err))
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Stop interrupts running purge and waits for Run to return
+func (a *App) Stop() {
+	const op = "purgerapp.Stop"
+
+	a.log.With(slog.String("op", op)).Info("stopping trash purger")
+	close(a.stop)
+	<-a.done
+}
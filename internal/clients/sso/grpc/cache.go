@@ -0,0 +1,193 @@
+package ssogrpc
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"github.com/golang-jwt/jwt/v5"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// TokenCache keeps results of token validation for a while, so the same token
+// isn't validated on every request. Tokens are stored by hash, least recently
+// used ones are evicted when the cache is full
+type TokenCache struct {
+	ttl         time.Duration
+	negativeTTL time.Duration
+	entries     *lru[[sha256.Size]byte, tokenEntry]
+}
+
+// AdminCache keeps answers of SSO whether users are admins, so tokens without
+// is_admin claim don't cost a call to SSO on every request
+type AdminCache struct {
+	ttl         time.Duration
+	negativeTTL time.Duration
+	entries     *lru[int64, bool]
+}
+
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+	Size   int
+}
+
+type tokenEntry struct {
+	userID int
+	email  string
+	err    error
+}
+
+// NewTokenCache returns cache of at most size tokens. Valid tokens are kept for ttl,
+// but not longer than they are valid, rejected ones for negativeTTL
+func NewTokenCache(size int, ttl, negativeTTL time.Duration) *TokenCache {
+	return &TokenCache{
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		entries:     newLRU[[sha256.Size]byte, tokenEntry](size),
+	}
+}
+
+// Stats returns counts of lookups since the cache was created and the number of cached tokens
+func (c *TokenCache) Stats() CacheStats {
+	return c.entries.stats()
+}
+
+// Returns cached result of the token validation for the app
+func (c *TokenCache) get(token string, appID int) (tokenEntry, bool) {
+	return c.entries.get(cacheKey(token, appID))
+}
+
+// Caches the user of a valid token, or the error of a rejected one if err is not nil
+func (c *TokenCache) add(token string, appID int, userID int, email string, err error) {
+	expiresAt := time.Now().Add(c.negativeTTL)
+	if err == nil {
+		expiresAt = time.Now().Add(c.ttl)
+		if exp, ok := tokenExpiry(token); ok && exp.Before(expiresAt) {
+			expiresAt = exp
+		}
+	}
+	if !time.Now().Before(expiresAt) {
+		return
+	}
+	c.entries.put(cacheKey(token, appID), tokenEntry{userID: userID, email: email, err: err}, expiresAt)
+}
+
+// NewAdminCache returns cache of at most size users. Answers of SSO are kept for ttl.
+// When SSO can't answer, the user is taken for not an admin for negativeTTL
+func NewAdminCache(size int, ttl, negativeTTL time.Duration) *AdminCache {
+	return &AdminCache{
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		entries:     newLRU[int64, bool](size),
+	}
+}
+
+// Stats returns counts of lookups since the cache was created and the number of cached users
+func (c *AdminCache) Stats() CacheStats {
+	return c.entries.stats()
+}
+
+// Returns whether SSO told the user is an admin
+func (c *AdminCache) get(userID int64) (isAdmin bool, ok bool) {
+	return c.entries.get(userID)
+}
+
+// Caches whether the user is an admin
+func (c *AdminCache) add(userID int64, isAdmin bool) {
+	c.entries.put(userID, isAdmin, time.Now().Add(c.ttl))
+}
+
+// Caches that SSO couldn't tell whether the user is an admin
+func (c *AdminCache) addFailure(userID int64) {
+	c.entries.put(userID, false, time.Now().Add(c.negativeTTL))
+}
+
+// Least recently used entries are evicted from lru when it is full,
+// expired ones are dropped when looked up
+type lru[K comparable, V any] struct {
+	size int
+
+	mu      sync.Mutex
+	entries map[K]*list.Element
+	// most recently used entries go first
+	order *list.List
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+type lruEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+func newLRU[K comparable, V any](size int) *lru[K, V] {
+	return &lru[K, V]{
+		size:    size,
+		entries: make(map[K]*list.Element, size),
+		order:   list.New(),
+	}
+}
+
+func (c *lru[K, V]) stats() CacheStats {
+	c.mu.Lock()
+	size := c.order.Len()
+	c.mu.Unlock()
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load(), Size: size}
+}
+
+func (c *lru[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		c.misses.Add(1)
+		var zero V
+		return zero, false
+	}
+	entry := el.Value.(*lruEntry[K, V])
+	if !time.Now().Before(entry.expiresAt) {
+		c.order.Remove(el)
+		delete(c.entries, key)
+		c.misses.Add(1)
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(el)
+	c.hits.Add(1)
+	return entry.value, true
+}
+
+func (c *lru[K, V]) put(key K, value V, expiresAt time.Time) {
+	entry := &lruEntry[K, V]{key: key, value: value, expiresAt: expiresAt}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		el.Value = entry
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry[K, V]).key)
+	}
+}
+
+func cacheKey(token string, appID int) [sha256.Size]byte {
+	return sha256.Sum256([]byte(strconv.Itoa(appID) + ":" + token))
+}
+
+// Returns expiry of the token without verifying it. Only the time
+// the cached result is kept depends on it, the token is verified anyway
+func tokenExpiry(token string) (time.Time, bool) {
+	var claims jwt.RegisteredClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err != nil || claims.ExpiresAt == nil {
+		return time.Time{}, false
+	}
+	return claims.ExpiresAt.Time, true
+}
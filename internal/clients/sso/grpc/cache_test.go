@@ -0,0 +1,135 @@
+package ssogrpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"github.com/golang-jwt/jwt/v5"
+	ssov1 "github.com/tendze/gRPC_AuthService_Proto/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newLRU[string, int](2)
+	expiresAt := time.Now().Add(time.Hour)
+	c.put("a", 1, expiresAt)
+	c.put("b", 2, expiresAt)
+	if v, ok := c.get("a"); !ok || v != 1 {
+		t.Fatalf("get(a) = %d, %v, want 1, true", v, ok)
+	}
+	// b is the least recently used now
+	c.put("c", 3, expiresAt)
+	if _, ok := c.get("b"); ok {
+		t.Errorf("b is not evicted")
+	}
+	// putting an existing key updates it without eviction
+	c.put("a", 4, expiresAt)
+	if v, ok := c.get("a"); !ok || v != 4 {
+		t.Errorf("get(a) = %d, %v, want 4, true", v, ok)
+	}
+	if _, ok := c.get("c"); !ok {
+		t.Errorf("c is evicted")
+	}
+
+	want := CacheStats{Hits: 3, Misses: 1, Size: 2}
+	if got := c.stats(); got != want {
+		t.Errorf("stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLRUDropsExpiredEntries(t *testing.T) {
+	c := newLRU[string, int](2)
+	c.put("a", 1, time.Now().Add(-time.Second))
+	c.put("b", 2, time.Now().Add(time.Hour))
+	if _, ok := c.get("a"); ok {
+		t.Errorf("expired entry is returned")
+	}
+
+	want := CacheStats{Hits: 0, Misses: 1, Size: 1}
+	if got := c.stats(); got != want {
+		t.Errorf("stats() = %+v, want %+v", got, want)
+	}
+}
+
+func expiryOf(t *testing.T, c *TokenCache, key [sha256.Size]byte) time.Time {
+	t.Helper()
+	el, ok := c.entries.entries[key]
+	if !ok {
+		t.Fatal("token is not cached")
+	}
+	return el.Value.(*lruEntry[[sha256.Size]byte, tokenEntry]).expiresAt
+}
+
+func TestTokenCacheKeepsTokensNotLongerThanValid(t *testing.T) {
+	c := NewTokenCache(10, time.Hour, time.Minute)
+	exp := time.Now().Add(time.Minute).Truncate(time.Second)
+	token := sign(t, jwt.SigningMethodHS256, "", claimsFor(testAppID, exp), []byte(testSecret))
+
+	c.add(token, testAppID, 7, "user@example.com", nil)
+	if got := expiryOf(t, c, cacheKey(token, testAppID)); !got.Equal(exp) {
+		t.Errorf("token is kept until %v, want %v", got, exp)
+	}
+	entry, ok := c.get(token, testAppID)
+	if !ok || entry.userID != 7 || entry.err != nil {
+		t.Errorf("get() = %+v, %v", entry, ok)
+	}
+	if _, ok = c.get(token, testAppID+1); ok {
+		t.Errorf("token is cached for another app")
+	}
+
+	expired := sign(t, jwt.SigningMethodHS256, "", claimsFor(testAppID, time.Now().Add(-time.Second)), []byte(testSecret))
+	c.add(expired, testAppID, 7, "user@example.com", nil)
+	if _, ok = c.get(expired, testAppID); ok {
+		t.Errorf("expired token is cached")
+	}
+}
+
+func TestTokenCacheKeepsRejectedTokensForNegativeTTL(t *testing.T) {
+	c := NewTokenCache(10, time.Hour, time.Minute)
+	token := sign(t, jwt.SigningMethodHS256, "", claimsFor(testAppID, time.Now().Add(time.Hour)), []byte(testSecret))
+
+	before := time.Now()
+	c.add(token, testAppID, 0, "", ErrInvalidToken)
+	got := expiryOf(t, c, cacheKey(token, testAppID))
+	if got.Before(before.Add(time.Minute)) || got.After(time.Now().Add(time.Minute)) {
+		t.Errorf("rejected token is kept until %v, want a minute", got)
+	}
+	if entry, ok := c.get(token, testAppID); !ok || !errors.Is(entry.err, ErrInvalidToken) {
+		t.Errorf("get() = %+v, %v, want %v", entry, ok, ErrInvalidToken)
+	}
+}
+
+func TestValidateTokenCachesOnlyRejections(t *testing.T) {
+	api := &fakeSSO{}
+	c := newTestClient(api, nil)
+	c.cache = NewTokenCache(10, time.Hour, time.Minute)
+	ctx := context.Background()
+
+	api.validate = func(string) (*ssov1.ValidateTokenResponse, error) {
+		return &ssov1.ValidateTokenResponse{IsValid: false}, nil
+	}
+	for i := 0; i < 2; i++ {
+		if _, _, _, err := c.ValidateToken(ctx, "rejected", testAppID); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("ValidateToken() error = %v, want %v", err, ErrInvalidToken)
+		}
+	}
+	if api.validateCalls != 1 {
+		t.Errorf("SSO called %d times for a rejected token, want 1", api.validateCalls)
+	}
+
+	api.validateCalls = 0
+	api.validate = func(string) (*ssov1.ValidateTokenResponse, error) {
+		return nil, status.Error(codes.Unavailable, "sso is down")
+	}
+	for i := 0; i < 2; i++ {
+		if _, _, _, err := c.ValidateToken(ctx, "other", testAppID); !errors.Is(err, ErrUnavailable) {
+			t.Errorf("ValidateToken() error = %v, want %v", err, ErrUnavailable)
+		}
+	}
+	if api.validateCalls != 2 {
+		t.Errorf("SSO called %d times while down, want 2", api.validateCalls)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"gRPC_ContactManagement_Service/internal/lib/errinfo"
 	grpclog "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
 	ssov1 "github.com/tendze/gRPC_AuthService_Proto/gen/go/sso"
@@ -18,17 +20,38 @@ import (
 	"time"
 )
 
+var (
+	ErrMissingToken   = errors.New("missing authorization token")
+	ErrMalformedToken = errors.New("malformed authorization token")
+	ErrInvalidToken   = errors.New("invalid authorization token")
+	ErrTokenExpired   = errors.New("authorization token expired")
+	// ErrUnavailable means SSO can't validate tokens now, the request may be retried later
+	ErrUnavailable = errors.New("auth service unavailable")
+)
+
 type Client struct {
 	api ssov1.AuthClient
 	log *slog.Logger
+	// verifier checks tokens locally before asking SSO, nil if disabled
+	verifier *LocalVerifier
+	// cache keeps results of validation, nil if disabled
+	cache *TokenCache
+	// admins keeps answers whether users are admins, nil if disabled
+	admins *AdminCache
 }
 
+// New returns SSO client. Tokens are validated by SSO only when verifier is nil
+// or can't decide whether the token is valid. Nil cache disables caching of the results,
+// nil admins disables caching of admin checks
 func New(
 	ctx context.Context,
 	log *slog.Logger,
 	addr string,
 	timeout time.Duration,
 	retriesCount int,
+	verifier *LocalVerifier,
+	cache *TokenCache,
+	admins *AdminCache,
 ) (*Client, error) {
 	const op = "sso.grpc.New"
 
@@ -56,14 +79,47 @@ func New(
 	}
 
 	return &Client{
-		api: ssov1.NewAuthClient(cc),
-		log: log,
+		api:      ssov1.NewAuthClient(cc),
+		log:      log,
+		verifier: verifier,
+		cache:    cache,
+		admins:   admins,
 	}, nil
 }
 
 func (c *Client) ValidateToken(ctx context.Context, token string, appID int) (userID int, email string, isValid bool, errw error) {
+	if c.cache == nil {
+		return c.validateToken(ctx, token, appID)
+	}
+	if entry, ok := c.cache.get(token, appID); ok {
+		return entry.userID, entry.email, entry.err == nil, entry.err
+	}
+
+	userID, email, isValid, errw = c.validateToken(ctx, token, appID)
+	switch {
+	case isValid:
+		c.cache.add(token, appID, userID, email, nil)
+	case errors.Is(errw, ErrInvalidToken), errors.Is(errw, ErrMalformedToken), errors.Is(errw, ErrTokenExpired):
+		// failures to reach SSO are not cached, only tokens it surely rejects
+		c.cache.add(token, appID, 0, "", errw)
+	}
+	return
+}
+
+func (c *Client) validateToken(ctx context.Context, token string, appID int) (userID int, email string, isValid bool, errw error) {
 	const op = "sso.grpc.ValidateToken"
 
+	if c.verifier != nil {
+		id, email, _, err := c.verifier.Verify(token, appID)
+		if err == nil {
+			return int(id), email, true, nil
+		}
+		if !errors.Is(err, errUndecided) {
+			return 0, "", false, fmt.Errorf("%s: %w", op, err)
+		}
+		c.log.Debug("token can't be verified locally, asking SSO", slog.String("op", op))
+	}
+
 	resp, err := c.api.ValidateToken(ctx, &ssov1.ValidateTokenRequest{
 		Token: token,
 		AppId: int32(appID),
@@ -72,20 +128,31 @@ func (c *Client) ValidateToken(ctx context.Context, token string, appID int) (us
 		userID, email, isValid = 0, "", false
 		grpcStatus, _ := status.FromError(err)
 		switch grpcStatus.Code() {
-		case codes.InvalidArgument:
-			errw = fmt.Errorf("%s: %w", op, errors.New("invalid authorization token"))
+		case codes.InvalidArgument, codes.Unauthenticated:
+			errw = fmt.Errorf("%s: %w", op, ErrInvalidToken)
 			return
 		case codes.NotFound:
 			errw = fmt.Errorf("%s: %w", op, errors.New("app not found"))
 			return
 		case codes.Internal:
-			errw = fmt.Errorf("%s: %w", op, errors.New("auth service internal error"))
+			errw = fmt.Errorf("%s: %w: %w", op, ErrUnavailable, errors.New("auth service internal error"))
+			return
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+			errw = fmt.Errorf("%s: %w: %w", op, ErrUnavailable, err)
 			return
 		}
 		errw = fmt.Errorf("%s: %w", op, err)
 		return
 	}
-	userID, _ = strconv.Atoi(resp.UserId)
+	if !resp.IsValid {
+		return 0, "", false, fmt.Errorf("%s: %w", op, ErrInvalidToken)
+	}
+	userID, err = strconv.Atoi(resp.UserId)
+	if err != nil || userID <= 0 {
+		userID, email, isValid = 0, "", false
+		errw = fmt.Errorf("%s: invalid user id %q", op, resp.UserId)
+		return
+	}
 	email, isValid, errw = resp.Email, true, nil
 	return
 }
@@ -93,29 +160,71 @@ func (c *Client) ValidateToken(ctx context.Context, token string, appID int) (us
 // SSOMiddleware SSO Interceptor
 func SSOMiddleware(authClient *Client, appID int) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
-		const op = "SSOMiddleware"
-		log := authClient.log.With(
-			slog.String("op", op),
-		)
-
-		log.Info("extracting authorization token from context")
-		token, err := extractTokenFromContext(ctx)
+		ctx, err = authorize(ctx, authClient, appID)
 		if err != nil {
 			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		userID, email, isValid, err := authClient.ValidateToken(ctx, token, appID)
-		_ = userID // user_id is unnecessary
+// SSOStreamMiddleware SSO Interceptor for streaming calls
+func SSOStreamMiddleware(authClient *Client, appID int) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), authClient, appID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to validate token: %w", err)
-		}
-		if !isValid {
-			return nil, errors.New("invalid authorization token")
+			return err
 		}
+		return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		ctx = context.WithValue(ctx, "creatorEmail", email)
-		return handler(ctx, req)
+// authorizedStream overrides stream context with the one carrying the principal
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorize validates token from metadata and puts the authenticated principal into context
+func authorize(ctx context.Context, authClient *Client, appID int) (context.Context, error) {
+	const op = "SSOMiddleware"
+	log := authClient.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("extracting authorization token from context")
+	token, err := extractTokenFromContext(ctx)
+	if err != nil {
+		log.Warn("request rejected", slog.String("error", err.Error()))
+		return nil, authStatus(err).Err()
+	}
+
+	userID, email, isValid, err := authClient.ValidateToken(ctx, token, appID)
+	if err == nil && !isValid {
+		err = ErrInvalidToken
+	}
+	if err != nil {
+		st := authStatus(err)
+		if st.Code() == codes.Unauthenticated {
+			log.Warn("request rejected", slog.String("error", err.Error()))
+		} else {
+			log.Error("failed to validate token", slog.String("error", err.Error()))
+		}
+		return nil, st.Err()
 	}
+
+	roles, adminCheck := authClient.Roles(token, int64(userID))
+	return auth.WithPrincipal(ctx, auth.Principal{
+		UserID:     int64(userID),
+		Email:      email,
+		AppID:      appID,
+		Roles:      roles,
+		AdminCheck: adminCheck,
+	}), nil
 }
 
 func interceptorLogger(l *slog.Logger) grpclog.Logger {
@@ -128,19 +237,40 @@ func interceptorLogger(l *slog.Logger) grpclog.Logger {
 func extractTokenFromContext(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return "", errors.New("missing metadata in context")
+		return "", fmt.Errorf("%w: missing metadata in context", ErrMissingToken)
 	}
 
 	authHeader, ok := md["authorization"]
 	if !ok || len(authHeader) == 0 {
-		return "", errors.New("missing authorization header")
+		return "", fmt.Errorf("%w: missing authorization header", ErrMissingToken)
 	}
 
 	// Извлекаем токен из заголовка: "Bearer <token>"
-	token := strings.TrimPrefix(authHeader[0], "Bearer ")
-	if token == "" {
-		return "", errors.New("invalid token format")
+	scheme, token, ok := strings.Cut(authHeader[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" || strings.ContainsAny(token, " \t") {
+		return "", fmt.Errorf("%w: expected \"Bearer <token>\"", ErrMalformedToken)
 	}
 
 	return token, nil
 }
+
+// Converts error of token validation to the status returned to the client. ErrorInfo
+// reason tells whether the client should get a new token or retry the request
+func authStatus(err error) *status.Status {
+	switch {
+	case errors.Is(err, ErrMissingToken):
+		return errinfo.Status(codes.Unauthenticated, "authorization token required", "TOKEN_MISSING", nil)
+	case errors.Is(err, ErrMalformedToken):
+		return errinfo.Status(codes.Unauthenticated, "malformed authorization token", "TOKEN_MALFORMED", nil)
+	case errors.Is(err, ErrTokenExpired):
+		return errinfo.Status(codes.Unauthenticated, "authorization token expired", "TOKEN_EXPIRED", nil)
+	case errors.Is(err, ErrInvalidToken):
+		return errinfo.Status(codes.Unauthenticated, "invalid authorization token", "TOKEN_INVALID", nil)
+	case errors.Is(err, ErrUnavailable):
+		return errinfo.Status(codes.Unavailable, "auth service unavailable", "AUTH_UNAVAILABLE", nil)
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err)
+	default:
+		return status.New(codes.Internal, "cannot validate authorization token")
+	}
+}
@@ -0,0 +1,119 @@
+package ssogrpc
+
+import (
+	"context"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"gRPC_ContactManagement_Service/internal/lib/errinfo"
+	"github.com/golang-jwt/jwt/v5"
+	ssov1 "github.com/tendze/gRPC_AuthService_Proto/gen/go/sso"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestSSOMiddlewareStatuses(t *testing.T) {
+	verifier, err := NewLocalVerifier(testSecret, "", testLeeway)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := time.Now().Add(time.Hour)
+	// tokens without app_id can't be verified locally and go to SSO
+	toSSO := func(token string) string {
+		return sign(t, jwt.SigningMethodHS256, "", jwt.MapClaims{"uid": 7, "sso": token, "exp": valid.Unix()}, []byte(testSecret))
+	}
+	api := &fakeSSO{validate: func(token string) (*ssov1.ValidateTokenResponse, error) {
+		claims := jwt.MapClaims{}
+		if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+			t.Fatal(err)
+		}
+		switch claims["sso"] {
+		case "down":
+			return nil, status.Error(codes.Unavailable, "sso is down")
+		case "slow":
+			return nil, status.Error(codes.DeadlineExceeded, "deadline exceeded")
+		case "rejected":
+			return &ssov1.ValidateTokenResponse{IsValid: false}, nil
+		}
+		return &ssov1.ValidateTokenResponse{UserId: "7", Email: "user@example.com", IsValid: true}, nil
+	}}
+	c := newTestClient(api, nil)
+	c.verifier = verifier
+	middleware := SSOMiddleware(c, testAppID)
+
+	tests := []struct {
+		name       string
+		md         metadata.MD
+		wantCode   codes.Code
+		wantReason string
+	}{
+		{"missing metadata", nil, codes.Unauthenticated, "TOKEN_MISSING"},
+		{"missing header", metadata.Pairs("x-request-id", "1"), codes.Unauthenticated, "TOKEN_MISSING"},
+		{"basic scheme", metadata.Pairs("authorization", "Basic dXNlcjpwYXNz"), codes.Unauthenticated, "TOKEN_MALFORMED"},
+		{"bearer without token", metadata.Pairs("authorization", "Bearer "), codes.Unauthenticated, "TOKEN_MALFORMED"},
+		{"bearer with spaces", metadata.Pairs("authorization", "Bearer a b"), codes.Unauthenticated, "TOKEN_MALFORMED"},
+		{"not a jwt", metadata.Pairs("authorization", "Bearer opaque"), codes.Unauthenticated, "TOKEN_MALFORMED"},
+		{
+			"expired",
+			metadata.Pairs("authorization", "Bearer "+sign(t, jwt.SigningMethodHS256, "", claimsFor(testAppID, time.Now().Add(-2*testLeeway)), []byte(testSecret))),
+			codes.Unauthenticated, "TOKEN_EXPIRED",
+		},
+		{
+			"invalid signature",
+			metadata.Pairs("authorization", "Bearer "+sign(t, jwt.SigningMethodHS256, "", claimsFor(testAppID, valid), []byte("other"))),
+			codes.Unauthenticated, "TOKEN_INVALID",
+		},
+		{"rejected by SSO", metadata.Pairs("authorization", "Bearer "+toSSO("rejected")), codes.Unauthenticated, "TOKEN_INVALID"},
+		{"SSO unavailable", metadata.Pairs("authorization", "Bearer "+toSSO("down")), codes.Unavailable, "AUTH_UNAVAILABLE"},
+		{"SSO deadline exceeded", metadata.Pairs("authorization", "Bearer "+toSSO("slow")), codes.Unavailable, "AUTH_UNAVAILABLE"},
+		{"valid", metadata.Pairs("authorization", "bearer "+toSSO("ok")), codes.OK, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			_, err := middleware(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				if user, ok := auth.FromContext(ctx); !ok || user.UserID != 7 {
+					t.Errorf("principal = %+v, %v", user, ok)
+				}
+				return nil, nil
+			})
+
+			st := status.Convert(err)
+			if st.Code() != tt.wantCode {
+				t.Fatalf("code = %s, want %s: %v", st.Code(), tt.wantCode, err)
+			}
+			if tt.wantReason == "" {
+				return
+			}
+			var info *errdetails.ErrorInfo
+			for _, d := range st.Details() {
+				if i, ok := d.(*errdetails.ErrorInfo); ok {
+					info = i
+				}
+			}
+			if info.GetReason() != tt.wantReason || info.GetDomain() != errinfo.Domain {
+				t.Errorf("error info = %v, want reason %s in %s", info, tt.wantReason, errinfo.Domain)
+			}
+		})
+	}
+}
+
+func TestAuthStatusOfContextErrors(t *testing.T) {
+	for _, tt := range []struct {
+		err  error
+		want codes.Code
+	}{
+		{context.Canceled, codes.Canceled},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+	} {
+		if got := authStatus(tt.err).Code(); got != tt.want {
+			t.Errorf("authStatus(%v) = %s, want %s", tt.err, got, tt.want)
+		}
+	}
+}
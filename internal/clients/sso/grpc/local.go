@@ -0,0 +1,159 @@
+package ssogrpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"sync"
+	"time"
+)
+
+// JWKS file is read again for an unknown kid at most this often,
+// so tokens with made up kids don't make every request read the file
+const jwksReloadInterval = 30 * time.Second
+
+var (
+	// errUndecided means the token can't be verified locally and has to be validated by SSO
+	errUndecided = errors.New("token can't be verified locally")
+	// errUnsigned is returned for tokens with alg "none", they are never valid
+	errUnsigned = errors.New("token is not signed")
+)
+
+// Claims of tokens issued by SSO
+type ssoClaims struct {
+	UserID int64  `json:"uid"`
+	Email  string `json:"email"`
+	AppID  int    `json:"app_id"`
+	jwt.RegisteredClaims
+}
+
+// LocalVerifier checks signature and expiry of tokens without calling SSO.
+// HMAC tokens are verified with the app secret, others with keys of a JWKS file
+type LocalVerifier struct {
+	secret   []byte
+	jwksPath string
+	leeway   time.Duration
+
+	mu       sync.RWMutex
+	keys     keySet
+	loadedAt time.Time
+}
+
+// NewLocalVerifier returns verifier using appSecret and keys of the JWKS file at jwksPath,
+// either may be empty. leeway is the allowed clock skew with SSO
+func NewLocalVerifier(appSecret, jwksPath string, leeway time.Duration) (*LocalVerifier, error) {
+	const op = "sso.grpc.NewLocalVerifier"
+
+	v := &LocalVerifier{
+		jwksPath: jwksPath,
+		leeway:   leeway,
+	}
+	if appSecret != "" {
+		v.secret = []byte(appSecret)
+	}
+	if jwksPath != "" {
+		keys, err := loadJWKS(jwksPath)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		v.keys, v.loadedAt = keys, time.Now()
+	}
+	return v, nil
+}
+
+// Verify returns user of a token issued for the app. Fails with ErrInvalidToken,
+// ErrMalformedToken or ErrTokenExpired when the token is surely rejected by SSO, and with
+// errUndecided when there is no key to verify it or the claims are unknown
+func (v *LocalVerifier) Verify(token string, appID int) (userID int64, email string, expiresAt time.Time, err error) {
+	var claims ssoClaims
+	_, err = jwt.ParseWithClaims(token, &claims, v.key, jwt.WithLeeway(v.leeway))
+	switch {
+	case errors.Is(err, errUnsigned):
+		return 0, "", time.Time{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	case errors.Is(err, jwt.ErrTokenUnverifiable):
+		return 0, "", time.Time{}, errUndecided
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return 0, "", time.Time{}, ErrTokenExpired
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		return 0, "", time.Time{}, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	case err != nil:
+		return 0, "", time.Time{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	if claims.AppID != 0 && claims.AppID != appID {
+		return 0, "", time.Time{}, fmt.Errorf("%w: issued for app %d", ErrInvalidToken, claims.AppID)
+	}
+	// SSO knows which app a token without app_id is for, and
+	// a token that never expires can't be trusted to be still valid
+	if claims.AppID == 0 || claims.ExpiresAt == nil || claims.UserID <= 0 || claims.Email == "" {
+		return 0, "", time.Time{}, errUndecided
+	}
+	return claims.UserID, claims.Email, claims.ExpiresAt.Time, nil
+}
+
+// Returns key of the token's signing method, so that e.g. a public RSA key
+// can't be used as an HMAC secret
+func (v *LocalVerifier) key(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	if token.Method == jwt.SigningMethodNone {
+		return nil, errUnsigned
+	}
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if v.secret != nil && kid == "" {
+			return v.secret, nil
+		}
+		if secret, ok := v.jwk(kid).([]byte); ok {
+			return secret, nil
+		}
+		if v.secret != nil {
+			return v.secret, nil
+		}
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		if key, ok := v.jwk(kid).(*rsa.PublicKey); ok {
+			return key, nil
+		}
+	case *jwt.SigningMethodECDSA:
+		if key, ok := v.jwk(kid).(*ecdsa.PublicKey); ok {
+			return key, nil
+		}
+	case *jwt.SigningMethodEd25519:
+		if key, ok := v.jwk(kid).(ed25519.PublicKey); ok {
+			return key, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported signing method %s", token.Method.Alg())
+	}
+	return nil, fmt.Errorf("no key %q for %s", kid, token.Method.Alg())
+}
+
+// Returns JWKS key with kid, reading the file again if the key is unknown
+// and the file wasn't read recently. Nil if there is no such key
+func (v *LocalVerifier) jwk(kid string) any {
+	if v.jwksPath == "" {
+		return nil
+	}
+
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	stale := time.Since(v.loadedAt) > jwksReloadInterval
+	v.mu.RUnlock()
+	if ok || !stale {
+		return key
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if time.Since(v.loadedAt) > jwksReloadInterval {
+		// keys loaded before stay in use if the file can't be read
+		if keys, err := loadJWKS(v.jwksPath); err == nil {
+			v.keys = keys
+		}
+		v.loadedAt = time.Now()
+	}
+	return v.keys[kid]
+}
@@ -0,0 +1,201 @@
+package ssogrpc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"github.com/golang-jwt/jwt/v5"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	testSecret = "app-secret"
+	testAppID  = 1
+	testLeeway = 30 * time.Second
+)
+
+func claimsFor(appID int, exp time.Time) jwt.MapClaims {
+	claims := jwt.MapClaims{"uid": 7, "email": "user@example.com", "exp": exp.Unix()}
+	if appID != 0 {
+		claims["app_id"] = appID
+	}
+	return claims
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, claims jwt.MapClaims, key any) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// Writes JWKS file with RSA public keys by kid
+func writeJWKS(t *testing.T, path string, keys map[string]*rsa.PublicKey) {
+	t.Helper()
+	var jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+	for kid, key := range keys {
+		jwks.Keys = append(jwks.Keys, jwk{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	data, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwksPath := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwksPath, map[string]*rsa.PublicKey{"rsa": &rsaKey.PublicKey})
+	v, err := NewLocalVerifier(testSecret, jwksPath, testLeeway)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the public key an attacker knows, used as HMAC secret
+	der, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	valid := time.Now().Add(time.Hour)
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{
+			name:  "hmac",
+			token: sign(t, jwt.SigningMethodHS256, "", claimsFor(testAppID, valid), []byte(testSecret)),
+		},
+		{
+			name:  "rsa",
+			token: sign(t, jwt.SigningMethodRS256, "rsa", claimsFor(testAppID, valid), rsaKey),
+		},
+		{
+			name:    "alg none",
+			token:   sign(t, jwt.SigningMethodNone, "", claimsFor(testAppID, valid), jwt.UnsafeAllowNoneSignatureType),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "hmac signed with rsa public key",
+			token:   sign(t, jwt.SigningMethodHS256, "rsa", claimsFor(testAppID, valid), publicPEM),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "wrong hmac secret",
+			token:   sign(t, jwt.SigningMethodHS256, "", claimsFor(testAppID, valid), []byte("other")),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "unknown kid",
+			token:   sign(t, jwt.SigningMethodRS256, "other", claimsFor(testAppID, valid), rsaKey),
+			wantErr: errUndecided,
+		},
+		{
+			name:  "expired within leeway",
+			token: sign(t, jwt.SigningMethodHS256, "", claimsFor(testAppID, time.Now().Add(-testLeeway/2)), []byte(testSecret)),
+		},
+		{
+			name:    "expired",
+			token:   sign(t, jwt.SigningMethodHS256, "", claimsFor(testAppID, time.Now().Add(-2*testLeeway)), []byte(testSecret)),
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name:    "wrong app",
+			token:   sign(t, jwt.SigningMethodHS256, "", claimsFor(testAppID+1, valid), []byte(testSecret)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "no app",
+			token:   sign(t, jwt.SigningMethodHS256, "", claimsFor(0, valid), []byte(testSecret)),
+			wantErr: errUndecided,
+		},
+		{
+			name:    "malformed",
+			token:   "not.a.token",
+			wantErr: ErrMalformedToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID, email, _, err := v.Verify(tt.token, testAppID)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (userID != 7 || email != "user@example.com") {
+				t.Errorf("Verify() = %d, %q", userID, email)
+			}
+		})
+	}
+}
+
+func TestVerifyReloadsJWKS(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwksPath := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwksPath, map[string]*rsa.PublicKey{"old": &oldKey.PublicKey})
+	v, err := NewLocalVerifier("", jwksPath, testLeeway)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := sign(t, jwt.SigningMethodRS256, "new", claimsFor(testAppID, time.Now().Add(time.Hour)), newKey)
+
+	// SSO rotated keys
+	writeJWKS(t, jwksPath, map[string]*rsa.PublicKey{"old": &oldKey.PublicKey, "new": &newKey.PublicKey})
+	if _, _, _, err = v.Verify(token, testAppID); !errors.Is(err, errUndecided) {
+		t.Fatalf("right after load: error = %v, want %v", err, errUndecided)
+	}
+
+	v.loadedAt = time.Now().Add(-jwksReloadInterval - time.Second)
+	if _, _, _, err = v.Verify(token, testAppID); err != nil {
+		t.Fatalf("after reload interval: error = %v", err)
+	}
+
+	// keys loaded before stay in use when the file can't be read
+	if err = os.Remove(jwksPath); err != nil {
+		t.Fatal(err)
+	}
+	v.loadedAt = time.Now().Add(-jwksReloadInterval - time.Second)
+	unknown := sign(t, jwt.SigningMethodRS256, "unknown", claimsFor(testAppID, time.Now().Add(time.Hour)), newKey)
+	if _, _, _, err = v.Verify(unknown, testAppID); !errors.Is(err, errUndecided) {
+		t.Fatalf("file removed: error = %v, want %v", err, errUndecided)
+	}
+	if _, _, _, err = v.Verify(token, testAppID); err != nil {
+		t.Fatalf("file removed: error = %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package ssogrpc
+
+import (
+	"context"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"github.com/golang-jwt/jwt/v5"
+	ssov1 "github.com/tendze/gRPC_AuthService_Proto/gen/go/sso"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+// Scopes of tokens limiting what the user can do with contacts.
+// A token without scopes grants everything the user is allowed to
+const (
+	ScopeRead  = "contacts:read"
+	ScopeWrite = "contacts:write"
+)
+
+// Claims of SSO tokens roles are resolved from
+type roleClaims struct {
+	// Scope is space separated as in RFC 8693
+	Scope string `json:"scope"`
+	// IsAdmin saves a call to SSO when SSO puts it into tokens
+	IsAdmin *bool `json:"is_admin"`
+	jwt.RegisteredClaims
+}
+
+// Roles returns roles of the user the token is issued to. The token must be validated before.
+// Admin role is granted when the token says so. When it doesn't tell, the admin check
+// asking SSO is returned instead, so only requests needing admin permissions wait for SSO
+func (c *Client) Roles(token string, userID int64) ([]auth.Role, func(ctx context.Context) bool) {
+	var claims roleClaims
+	// the token is already validated, claims are only read
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err != nil {
+		claims = roleClaims{}
+	}
+
+	roles := []auth.Role{auth.RoleUser}
+	if claims.Scope != "" {
+		scopes := strings.Fields(claims.Scope)
+		switch {
+		case slices.Contains(scopes, ScopeWrite):
+		case slices.Contains(scopes, ScopeRead):
+			roles = []auth.Role{auth.RoleReader}
+		default:
+			roles = nil
+		}
+	}
+
+	if claims.IsAdmin == nil {
+		return roles, func(ctx context.Context) bool {
+			return c.isAdmin(ctx, userID)
+		}
+	}
+	if *claims.IsAdmin {
+		roles = append(roles, auth.RoleAdmin)
+	}
+	return roles, nil
+}
+
+// If SSO can't answer, the user is not an admin
+func (c *Client) isAdmin(ctx context.Context, userID int64) bool {
+	const op = "sso.grpc.IsAdmin"
+
+	if c.admins != nil {
+		if isAdmin, ok := c.admins.get(userID); ok {
+			return isAdmin
+		}
+	}
+
+	resp, err := c.api.IsAdmin(ctx, &ssov1.IsAdminRequest{UserId: userID})
+	if err != nil {
+		// cached for a short time, so requests don't wait for SSO while it is down
+		// and the user gets admin role back soon after SSO answers again
+		c.log.Warn("cannot check if user is admin",
+			slog.String("op", op),
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		if c.admins != nil {
+			c.admins.addFailure(userID)
+		}
+		return false
+	}
+	if c.admins != nil {
+		c.admins.add(userID, resp.IsAdmin)
+	}
+	return resp.IsAdmin
+}
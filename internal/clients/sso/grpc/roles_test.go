@@ -0,0 +1,128 @@
+package ssogrpc
+
+import (
+	"context"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"github.com/golang-jwt/jwt/v5"
+	ssov1 "github.com/tendze/gRPC_AuthService_Proto/gen/go/sso"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"log/slog"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeSSO answers IsAdmin with admins and ValidateToken with validate and counts the calls,
+// other methods panic
+type fakeSSO struct {
+	ssov1.AuthClient
+	admins        map[int64]bool
+	down          bool
+	adminCalls    int
+	validate      func(token string) (*ssov1.ValidateTokenResponse, error)
+	validateCalls int
+}
+
+func (f *fakeSSO) ValidateToken(_ context.Context, in *ssov1.ValidateTokenRequest, _ ...grpc.CallOption) (*ssov1.ValidateTokenResponse, error) {
+	f.validateCalls++
+	return f.validate(in.GetToken())
+}
+
+func (f *fakeSSO) IsAdmin(_ context.Context, in *ssov1.IsAdminRequest, _ ...grpc.CallOption) (*ssov1.IsAdminResponse, error) {
+	f.adminCalls++
+	if f.down {
+		return nil, status.Error(codes.Unavailable, "sso is down")
+	}
+	return &ssov1.IsAdminResponse{IsAdmin: f.admins[in.GetUserId()]}, nil
+}
+
+func newTestClient(api ssov1.AuthClient, admins *AdminCache) *Client {
+	return &Client{api: api, log: slog.New(slog.NewTextHandler(io.Discard, nil)), admins: admins}
+}
+
+func TestRoles(t *testing.T) {
+	tests := []struct {
+		name      string
+		claims    jwt.MapClaims
+		want      []auth.Role
+		wantCheck bool
+	}{
+		{"no scope", jwt.MapClaims{"is_admin": false}, []auth.Role{auth.RoleUser}, false},
+		{"write scope", jwt.MapClaims{"scope": "openid contacts:read contacts:write", "is_admin": false}, []auth.Role{auth.RoleUser}, false},
+		{"read scope", jwt.MapClaims{"scope": "contacts:read", "is_admin": false}, []auth.Role{auth.RoleReader}, false},
+		{"other scopes", jwt.MapClaims{"scope": "openid profile", "is_admin": false}, nil, false},
+		{"admin claim", jwt.MapClaims{"scope": "contacts:read", "is_admin": true}, []auth.Role{auth.RoleReader, auth.RoleAdmin}, false},
+		{"no admin claim", jwt.MapClaims{"scope": "contacts:read"}, []auth.Role{auth.RoleReader}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeSSO{admins: map[int64]bool{7: true}}
+			c := newTestClient(api, nil)
+			token := sign(t, jwt.SigningMethodHS256, "", tt.claims, []byte(testSecret))
+
+			roles, check := c.Roles(token, 7)
+			if !reflect.DeepEqual(roles, tt.want) {
+				t.Errorf("Roles() = %v, want %v", roles, tt.want)
+			}
+			if (check != nil) != tt.wantCheck {
+				t.Errorf("Roles() admin check = %v, want %v", check != nil, tt.wantCheck)
+			}
+			if api.adminCalls != 0 {
+				t.Errorf("Roles() called IsAdmin %d times, want 0", api.adminCalls)
+			}
+		})
+	}
+}
+
+func TestRolesOfMalformedToken(t *testing.T) {
+	roles, check := newTestClient(&fakeSSO{}, nil).Roles("opaque", 7)
+	if !reflect.DeepEqual(roles, []auth.Role{auth.RoleUser}) || check == nil {
+		t.Errorf("Roles() = %v, %v, want user role and admin check", roles, check != nil)
+	}
+}
+
+func TestAdminCheckAsksSSOOnlyWhenNeeded(t *testing.T) {
+	api := &fakeSSO{admins: map[int64]bool{7: true}}
+	c := newTestClient(api, NewAdminCache(10, time.Minute, time.Minute))
+	token := sign(t, jwt.SigningMethodHS256, "", jwt.MapClaims{"scope": "contacts:read"}, []byte(testSecret))
+	ctx := context.Background()
+
+	roles, check := c.Roles(token, 7)
+	p := auth.Principal{UserID: 7, Roles: roles, AdminCheck: check}
+	if !p.Allows(ctx, auth.PermRead) || p.Allows(ctx, auth.PermWrite) {
+		t.Errorf("reader permissions are wrong")
+	}
+	if api.adminCalls != 0 {
+		t.Fatalf("IsAdmin called %d times for reader permissions, want 0", api.adminCalls)
+	}
+
+	if !p.Allows(ctx, auth.PermReadAll) || !p.Allows(ctx, auth.PermReadAll) {
+		t.Errorf("admin is not allowed to read all")
+	}
+	if api.adminCalls != 1 {
+		t.Errorf("IsAdmin called %d times, want 1 with the cache", api.adminCalls)
+	}
+
+	roles, check = c.Roles(token, 8)
+	p = auth.Principal{UserID: 8, Roles: roles, AdminCheck: check}
+	if p.Allows(ctx, auth.PermReadAll) {
+		t.Errorf("user 8 is allowed to read all")
+	}
+}
+
+func TestAdminCheckWhenSSOIsDown(t *testing.T) {
+	api := &fakeSSO{admins: map[int64]bool{7: true}, down: true}
+	c := newTestClient(api, NewAdminCache(10, time.Minute, time.Minute))
+	ctx := context.Background()
+
+	_, check := c.Roles("opaque", 7)
+	if check(ctx) || check(ctx) {
+		t.Errorf("admin check = true while SSO is down")
+	}
+	if api.adminCalls != 1 {
+		t.Errorf("IsAdmin called %d times, want 1 with the failure cached", api.adminCalls)
+	}
+}
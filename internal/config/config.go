@@ -1,9 +1,12 @@
 package config
 
 import (
+	"errors"
 	"flag"
+	"gRPC_ContactManagement_Service/internal/lib/phonenum"
 	"github.com/ilyakaznacheev/cleanenv"
 	"os"
+	"strings"
 	"time"
 )
 
@@ -11,6 +14,11 @@ type Config struct {
 	Env         string       `yaml:"env" env-default:"local"`
 	StoragePath string       `yaml:"storage_path" env-required:"true"`
 	GRPC        GRPCConfig   `yaml:"grpc"`
+	Search      SearchConfig `yaml:"search"`
+	Phone       PhoneConfig  `yaml:"phone"`
+	Trash       TrashConfig  `yaml:"trash"`
+	Watch       WatchConfig  `yaml:"watch"`
+	Sync        SyncConfig   `yaml:"sync"`
 	Clients     ClientConfig `yaml:"clients"`
 }
 
@@ -19,6 +27,33 @@ type GRPCConfig struct {
 	Timeout time.Duration `yaml:"timeout"`
 }
 
+type SearchConfig struct {
+	// Minimal similarity in [0, 1] of a name returned by fuzzy search
+	FuzzyThreshold float64 `yaml:"fuzzy_threshold" env-default:"0.5"`
+}
+
+type PhoneConfig struct {
+	// ISO 3166-1 alpha-2 region of phone numbers written without country code
+	DefaultRegion string `yaml:"default_region" env-default:"RU"`
+}
+
+type TrashConfig struct {
+	// How long deleted contacts are kept in trash, zero keeps them forever
+	Retention     time.Duration `yaml:"retention" env-default:"720h"`
+	PurgeInterval time.Duration `yaml:"purge_interval" env-default:"1h"`
+}
+
+type WatchConfig struct {
+	// How many last contact changes are kept for watchers resuming after a reconnect
+	HistorySize int `yaml:"history_size" env-default:"10000"`
+}
+
+type SyncConfig struct {
+	// How long purged contacts are reported to syncing clients, zero keeps them forever.
+	// Clients that haven't synced for longer have to sync from scratch
+	TombstoneRetention time.Duration `yaml:"tombstone_retention" env-default:"2160h"`
+}
+
 type ClientConfig struct {
 	SSO Client `yaml:"sso"`
 }
@@ -29,6 +64,23 @@ type Client struct {
 	RetriesCount int           `yaml:"retries_count"`
 	Insecure     bool          `yaml:"insecure"`
 	AppID        int           `yaml:"app_id" env-required:"true"`
+	// Tokens are verified locally when the app secret or the JWKS file with SSO keys is set,
+	// SSO validates only tokens that can't be verified with them
+	AppSecret string `yaml:"app_secret" env:"SSO_APP_SECRET"`
+	JWKSPath  string `yaml:"jwks_path"`
+	// Allowed clock skew with SSO when checking token expiry
+	Leeway time.Duration `yaml:"leeway" env-default:"30s"`
+	// Results of token validation are cached by token hash, zero size disables the cache.
+	// Valid tokens are cached for cache_ttl but not after they expire, rejected ones for negative_cache_ttl
+	CacheSize        int           `yaml:"cache_size" env-default:"10000"`
+	CacheTTL         time.Duration `yaml:"cache_ttl" env-default:"5m"`
+	NegativeCacheTTL time.Duration `yaml:"negative_cache_ttl" env-default:"10s"`
+	// Answers of SSO whether users are admins are cached apart from tokens, zero size disables the cache.
+	// They are kept for admin_cache_ttl, failed checks for negative_cache_ttl
+	AdminCacheSize int           `yaml:"admin_cache_size" env-default:"10000"`
+	AdminCacheTTL  time.Duration `yaml:"admin_cache_ttl" env-default:"5m"`
+	// Hits and misses of the caches are logged this often and at shutdown
+	CacheStatsInterval time.Duration `yaml:"cache_stats_interval" env-default:"5m"`
 }
 
 func MustLoad() *Config {
@@ -51,15 +103,29 @@ func fetchConfigPath() string {
 }
 
 func MustLoadByPath(configPath string) *Config {
+	cfg, err := LoadByPath(configPath)
+	if err != nil {
+		panic(err.Error())
+	}
+	return cfg
+}
+
+// LoadByPath reads config like MustLoadByPath, but returns errors
+func LoadByPath(configPath string) (*Config, error) {
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		panic("config file does not exists: " + configPath)
+		return nil, errors.New("config file does not exists: " + configPath)
 	}
 
 	var cfg Config
 
 	if err := cleanenv.ReadConfig(configPath, &cfg); err != nil {
-		panic("cannot read config: " + err.Error())
+		return nil, errors.New("cannot read config: " + err.Error())
+	}
+
+	cfg.Phone.DefaultRegion = strings.ToUpper(cfg.Phone.DefaultRegion)
+	if !phonenum.IsSupportedRegion(cfg.Phone.DefaultRegion) {
+		return nil, errors.New("unsupported phone.default_region: " + cfg.Phone.DefaultRegion)
 	}
 
-	return &cfg
+	return &cfg, nil
 }
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadByPathChecksPhoneRegion(t *testing.T) {
+	tests := []struct {
+		region  string
+		want    string
+		wantErr bool
+	}{
+		{region: "GB", want: "GB"},
+		{region: "de", want: "DE"},
+		{region: "", want: "RU"},
+		{region: "XX", wantErr: true},
+		{region: "Russia", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.region, func(t *testing.T) {
+			data := "storage_path: ./cm.db\nclients:\n  sso:\n    app_id: 1\n"
+			if tt.region != "" {
+				data += "phone:\n  default_region: " + tt.region + "\n"
+			}
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := LoadByPath(path)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "phone.default_region") {
+					t.Fatalf("LoadByPath() error = %v, want unsupported region", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadByPath() error = %v", err)
+			}
+			if cfg.Phone.DefaultRegion != tt.want {
+				t.Errorf("DefaultRegion = %q, want %q", cfg.Phone.DefaultRegion, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	userKeyPrefix     = "user:"
+	teamBookKeyPrefix = "book:"
+)
+
+// BookRole is what a member may do in an address book, greater role includes lesser ones
+type BookRole int
+
+const (
+	RoleNone BookRole = iota
+	// RoleViewer reads contacts of the book
+	RoleViewer
+	// RoleEditor also creates, changes and deletes contacts, groups and tags
+	RoleEditor
+	// RoleOwner also manages members, uniqueness policy and shares of the book
+	RoleOwner
+)
+
+// AddressBook is a directory of contacts of a user or of a team.
+// Every user has a personal book they own
+type AddressBook struct {
+	ID       int64
+	Name     string
+	Personal bool
+	// OwnerKey is the key contacts and other data of the book are stored under:
+	// UserKey of the owner for personal books and TeamBookKey for team books
+	OwnerKey string
+	// Role of the user the book is returned to
+	Role      BookRole
+	CreatedAt time.Time
+}
+
+type AddressBookMember struct {
+	Email     string
+	Role      BookRole
+	CreatedAt time.Time
+}
+
+// UserKey returns OwnerKey of the personal book of the SSO user with id
+func UserKey(id int64) string {
+	return userKeyPrefix + strconv.FormatInt(id, 10)
+}
+
+// TeamBookKey returns OwnerKey of the team book with id
+func TeamBookKey(id int64) string {
+	return teamBookKeyPrefix + strconv.FormatInt(id, 10)
+}
+
+// IsTeamBookKey tells whether the owner key of contacts is
+// the key of a team book rather than of a user
+func IsTeamBookKey(key string) bool {
+	return strings.HasPrefix(key, teamBookKeyPrefix)
+}
@@ -0,0 +1,17 @@
+package models
+
+// ContactRef points to a contact expected to have the given version.
+// Zero version matches any
+type ContactRef struct {
+	ID      int64
+	Version int64
+	// OwnerKey is the key the contact is stored under, known once access to it is checked
+	OwnerKey string
+}
+
+// BatchResult is the outcome of one item of a batch operation.
+// ID is the id of a created contact
+type BatchResult struct {
+	ID  int64
+	Err error
+}
@@ -1,9 +1,95 @@
 package models
 
+import "time"
+
 type Contact struct {
-	ID           int64
-	CreatorEmail string
-	Name         string
-	Email        string
-	Phone        string
+	ID       int64
+	OwnerKey string
+	// AddressBookID is the book the contact belongs to, OwnerKey is the book's OwnerKey
+	AddressBookID int64
+	// OwnerEmail is the current email of the owner of a personal book, empty for team books
+	OwnerEmail string
+	Name       string
+	// Email and Phone are the primary values of Emails and Phones
+	Email     string
+	Phone     string
+	CreatedAt time.Time
+	// DeletedAt is zero unless the contact is in trash
+	DeletedAt time.Time
+	// Version is incremented by every change of the contact
+	Version int64
+	Emails  []ContactEmail
+	Phones  []ContactPhone
+	Tags    []string
+}
+
+type Label string
+
+const (
+	LabelWork   Label = "work"
+	LabelHome   Label = "home"
+	LabelMobile Label = "mobile"
+	LabelOther  Label = "other"
+)
+
+type ContactEmail struct {
+	Email   string
+	Label   Label
+	Primary bool
+}
+
+type ContactPhone struct {
+	Phone   string
+	Label   Label
+	Primary bool
+}
+
+// ContactMatch is a contact found by fuzzy search.
+// Score is similarity of the contact to the query in [0, 1]
+type ContactMatch struct {
+	Contact Contact
+	Score   float64
+}
+
+// ContactUpdate holds the fields of a contact to be changed.
+// Nil fields are left untouched. Email and Phone change the primary values,
+// Emails and Phones replace the whole lists
+type ContactUpdate struct {
+	Name   *string
+	Email  *string
+	Phone  *string
+	Emails []ContactEmail
+	Phones []ContactPhone
+}
+
+// ContactOrder is a field contacts are sorted by when listing.
+type ContactOrder int
+
+const (
+	OrderByID ContactOrder = iota
+	OrderByName
+	OrderByEmail
+	OrderByCreatedAt
+	OrderByDeletedAt
+)
+
+// ContactFilter narrows listing and search down to
+// members of a group and contacts with a tag. Zero values don't filter.
+// Contacts in trash are listed only with Deleted set,
+// contacts shared with the user only with Shared set
+type ContactFilter struct {
+	GroupID int64
+	Tag     string
+	Deleted bool
+	Shared  bool
+}
+
+// ListOptions describes a single page of contacts.
+// After is the last contact of the previous page, nil for the first page.
+type ListOptions struct {
+	Filter  ContactFilter
+	OrderBy ContactOrder
+	Desc    bool
+	Limit   int
+	After   *Contact
 }
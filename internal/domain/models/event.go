@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+type ContactEventType string
+
+const (
+	ContactCreated ContactEventType = "created"
+	ContactUpdated ContactEventType = "updated"
+	ContactDeleted ContactEventType = "deleted"
+)
+
+// ContactEvent is a change of a contact pushed to watchers.
+// Contact is the state after the change, nil for deleted contacts
+type ContactEvent struct {
+	ID        int64
+	Type      ContactEventType
+	ContactID int64
+	Contact   *ContactSnapshot
+	CreatedAt time.Time
+}
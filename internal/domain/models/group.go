@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+type Group struct {
+	ID           int64
+	OwnerKey     string
+	Name         string
+	CreatedAt    time.Time
+	MembersCount int64
+}
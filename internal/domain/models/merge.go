@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// Merge is a merge of duplicate contacts into the survivor.
+// Merged contacts are moved to trash, so the merge can be undone
+type Merge struct {
+	ID         int64
+	OwnerKey   string
+	SurvivorID int64
+	MergedIDs  []int64
+	CreatedAt  time.Time
+}
+
+type DuplicateReason string
+
+const (
+	DuplicateEmail DuplicateReason = "email"
+	DuplicatePhone DuplicateReason = "phone"
+	DuplicateName  DuplicateReason = "name"
+)
+
+// DuplicateCluster is a group of contacts that are likely the same person.
+// Reasons tell what the contacts have in common
+type DuplicateCluster struct {
+	Contacts []Contact
+	Reasons  []DuplicateReason
+}
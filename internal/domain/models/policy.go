@@ -0,0 +1,23 @@
+package models
+
+import "fmt"
+
+// UniquenessPolicy tells which fields must be unique among owner's contacts.
+// Emails are compared case-insensitively, contacts in trash are not taken into account
+type UniquenessPolicy struct {
+	UniqueEmail bool
+	UniquePhone bool
+}
+
+// ContactExistsError tells which unique field of a contact collided with an existing contact.
+// Storage and service wrap it into their ErrContactExists. The value itself is not kept,
+// so it doesn't end up in logs
+type ContactExistsError struct {
+	// Field is "email" or "phone"
+	Field      string
+	ExistingID int64
+}
+
+func (e *ContactExistsError) Error() string {
+	return fmt.Sprintf("%s is used by contact %d", e.Field, e.ExistingID)
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+type RevisionAction string
+
+const (
+	RevisionCreate  RevisionAction = "create"
+	RevisionUpdate  RevisionAction = "update"
+	RevisionDelete  RevisionAction = "delete"
+	RevisionRestore RevisionAction = "restore"
+	// RevisionRevert is a return of the contact to an earlier revision
+	RevisionRevert RevisionAction = "revert"
+	// RevisionPurge is a permanent removal of the contact from trash
+	RevisionPurge RevisionAction = "purge"
+)
+
+// SystemAuthor is the author of changes the service makes on its own,
+// such as purge of contacts that have been in trash too long
+const SystemAuthor = "system"
+
+// ContactSnapshot is the state of contact's fields kept in a revision
+type ContactSnapshot struct {
+	Name   string         `json:"name"`
+	Emails []ContactEmail `json:"emails"`
+	Phones []ContactPhone `json:"phones"`
+}
+
+// Revision is an immutable record of a single change of a contact.
+// Before is nil for created contacts, After is nil for deleted and purged ones
+type Revision struct {
+	ID        int64
+	ContactID int64
+	OwnerKey  string
+	Author    string
+	Action    RevisionAction
+	CreatedAt time.Time
+	Before    *ContactSnapshot
+	After     *ContactSnapshot
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// Access is what a user may do with a contact, greater access includes lesser ones
+type Access int
+
+const (
+	AccessNone Access = iota
+	AccessRead
+	AccessWrite
+	// AccessOwner is the access of contact's owner, it can't be shared
+	AccessOwner
+)
+
+// Share grants a user access to owner's contact or to all contacts
+// of owner's group. Exactly one of ContactID and GroupID is set
+type Share struct {
+	ID           int64
+	OwnerKey     string
+	ContactID    int64
+	GroupID      int64
+	GranteeEmail string
+	Access       Access
+	CreatedAt    time.Time
+}
@@ -0,0 +1,9 @@
+package models
+
+// ContactChange is a contact changed after a sync. Seq is the number of the change
+// in the owner's sequence. Deleted contacts are tombstones with only Contact.ID set
+type ContactChange struct {
+	Seq     int64
+	Contact Contact
+	Deleted bool
+}
@@ -0,0 +1,65 @@
+package authz
+
+import (
+	"context"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"gRPC_ContactManagement_Service/internal/lib/errinfo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+)
+
+// UnaryServerInterceptor checks the principal authenticated by SSO interceptor
+// has the permission policy requires for the method. Must be chained after it
+func UnaryServerInterceptor(log *slog.Logger, policy map[string]auth.Permission) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authorize(ctx, log, policy, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming calls
+func StreamServerInterceptor(log *slog.Logger, policy map[string]auth.Permission) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), log, policy, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, log *slog.Logger, policy map[string]auth.Permission, method string) error {
+	const op = "authz.authorize"
+	log = log.With(
+		slog.String("op", op),
+		slog.String("method", method),
+	)
+
+	user, ok := auth.FromContext(ctx)
+	if !ok {
+		log.Error("request has no principal, authz must run after SSO interceptor")
+		return status.Error(codes.Internal, "cannot authorize request")
+	}
+	log = log.With(
+		slog.Int64("user_id", user.UserID),
+		slog.Any("roles", user.Roles),
+	)
+
+	perm, ok := policy[method]
+	if !ok {
+		log.Warn("permission denied: method has no policy")
+		return deniedStatus().Err()
+	}
+	if !user.Can(perm) {
+		log.Warn("permission denied")
+		return deniedStatus().Err()
+	}
+	return nil
+}
+
+func deniedStatus() *status.Status {
+	return errinfo.Status(codes.PermissionDenied, "operation not permitted", "PERMISSION_DENIED", nil)
+}
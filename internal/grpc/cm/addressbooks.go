@@ -0,0 +1,224 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxAddressBookNameLength = 100
+
+var bookRoles = map[cmv1.AddressBookRole]models.BookRole{
+	cmv1.AddressBookRole_ADDRESS_BOOK_ROLE_VIEWER: models.RoleViewer,
+	cmv1.AddressBookRole_ADDRESS_BOOK_ROLE_EDITOR: models.RoleEditor,
+	cmv1.AddressBookRole_ADDRESS_BOOK_ROLE_OWNER:  models.RoleOwner,
+}
+
+var bookRolesToProto = map[models.BookRole]cmv1.AddressBookRole{
+	models.RoleViewer: cmv1.AddressBookRole_ADDRESS_BOOK_ROLE_VIEWER,
+	models.RoleEditor: cmv1.AddressBookRole_ADDRESS_BOOK_ROLE_EDITOR,
+	models.RoleOwner:  cmv1.AddressBookRole_ADDRESS_BOOK_ROLE_OWNER,
+}
+
+func (s *serverAPI) CreateAddressBook(
+	ctx context.Context,
+	req *cmv1.CreateAddressBookRequest,
+) (*cmv1.CreateAddressBookResponse, error) {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name required")
+	}
+	if utf8.RuneCountInString(name) > maxAddressBookNameLength {
+		return nil, status.Error(codes.InvalidArgument, "name is too long")
+	}
+
+	user, _, err := s.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.cm.CreateAddressBook(ctx, user.Email, name)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "cannot create address book")
+	}
+	return &cmv1.CreateAddressBookResponse{Id: id, Success: true}, nil
+}
+
+func (s *serverAPI) ListAddressBooks(
+	ctx context.Context,
+	req *cmv1.ListAddressBooksRequest,
+) (*cmv1.ListAddressBooksResponse, error) {
+	_, ownerKey, err := s.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	books, err := s.cm.ListAddressBooks(ctx, ownerKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "cannot list address books")
+	}
+
+	resp := &cmv1.ListAddressBooksResponse{
+		AddressBooks: make([]*cmv1.AddressBook, 0, len(books)),
+	}
+	for _, book := range books {
+		resp.AddressBooks = append(resp.AddressBooks, &cmv1.AddressBook{
+			Id:        book.ID,
+			Name:      book.Name,
+			Personal:  book.Personal,
+			Role:      bookRolesToProto[book.Role],
+			CreatedAt: book.CreatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) ListAddressBookMembers(
+	ctx context.Context,
+	req *cmv1.ListAddressBookMembersRequest,
+) (*cmv1.ListAddressBookMembersResponse, error) {
+	if req.GetAddressBookId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "address_book_id required")
+	}
+
+	user, ownerKey, err := s.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.cm.ListAddressBookMembers(ctx, ownerKey, user.Email, req.GetAddressBookId())
+	if err != nil {
+		if st, ok := addressBookStatus(err); ok {
+			return nil, st.Err()
+		}
+		return nil, status.Error(codes.Internal, "cannot list address book members")
+	}
+
+	resp := &cmv1.ListAddressBookMembersResponse{
+		Members: make([]*cmv1.AddressBookMember, 0, len(members)),
+	}
+	for _, member := range members {
+		resp.Members = append(resp.Members, &cmv1.AddressBookMember{
+			Email:     member.Email,
+			Role:      bookRolesToProto[member.Role],
+			CreatedAt: member.CreatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) SetAddressBookMember(
+	ctx context.Context,
+	req *cmv1.SetAddressBookMemberRequest,
+) (*cmv1.SetAddressBookMemberResponse, error) {
+	if req.GetAddressBookId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "address_book_id required")
+	}
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email required")
+	}
+	if err := validateEmail(req.GetEmail()); err != nil {
+		return nil, err
+	}
+	role, ok := bookRoles[req.GetRole()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "role required")
+	}
+
+	_, ownerKey, err := s.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cm.SetAddressBookMember(ctx, ownerKey, req.GetAddressBookId(), req.GetEmail(), role)
+	if err != nil {
+		if st, ok := addressBookStatus(err); ok {
+			return nil, st.Err()
+		}
+		return nil, status.Error(codes.Internal, "cannot set address book member")
+	}
+	return &cmv1.SetAddressBookMemberResponse{Success: true}, nil
+}
+
+func (s *serverAPI) RemoveAddressBookMember(
+	ctx context.Context,
+	req *cmv1.RemoveAddressBookMemberRequest,
+) (*cmv1.RemoveAddressBookMemberResponse, error) {
+	if req.GetAddressBookId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "address_book_id required")
+	}
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email required")
+	}
+
+	user, ownerKey, err := s.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cm.RemoveAddressBookMember(ctx, ownerKey, user.Email, req.GetAddressBookId(), req.GetEmail())
+	if err != nil {
+		if st, ok := addressBookStatus(err); ok {
+			return nil, st.Err()
+		}
+		return nil, status.Error(codes.Internal, "cannot remove address book member")
+	}
+	return &cmv1.RemoveAddressBookMemberResponse{Success: true}, nil
+}
+
+// Resolves the address book the request works with and returns the key its contacts
+// are stored under, checking the caller has the role in the book. Zero bookID is
+// the caller's personal book. Users allowed to read every owner's data can view any book
+func (s *serverAPI) addressBook(
+	ctx context.Context,
+	bookID int64,
+	role models.BookRole,
+) (string, error) {
+	if bookID < 0 {
+		return "", status.Error(codes.InvalidArgument, "invalid address_book_id")
+	}
+
+	user, ownerKey, err := s.user(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := s.cm.AddressBookKey(ctx, ownerKey, bookID, role)
+	// admins may read any address book, SSO is asked only when the user has no access of their own
+	if role == models.RoleViewer &&
+		(errors.Is(err, cm.ErrAddressBookNotFound) || errors.Is(err, cm.ErrPermissionDenied)) &&
+		user.Allows(ctx, auth.PermReadAll) {
+		key, err = s.cm.AnyAddressBookKey(ctx, bookID)
+	}
+	if err != nil {
+		if st, ok := addressBookStatus(err); ok {
+			return "", st.Err()
+		}
+		return "", status.Error(codes.Internal, "cannot get address book")
+	}
+	return key, nil
+}
+
+// Converts errors of address book access and membership to statuses
+func addressBookStatus(err error) (*status.Status, bool) {
+	switch {
+	case errors.Is(err, cm.ErrAddressBookNotFound):
+		return status.New(codes.NotFound, "address book not found"), true
+	case errors.Is(err, cm.ErrPermissionDenied):
+		return status.New(codes.PermissionDenied, "not enough rights in address book"), true
+	case errors.Is(err, cm.ErrMemberNotFound):
+		return status.New(codes.NotFound, "member not found"), true
+	case errors.Is(err, cm.ErrLastBookOwner):
+		return status.New(codes.FailedPrecondition, "address book must have an owner"), true
+	case errors.Is(err, cm.ErrPersonalAddressBook):
+		return status.New(codes.FailedPrecondition, "personal address book has no members"), true
+	}
+	return nil, false
+}
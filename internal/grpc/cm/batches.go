@@ -0,0 +1,173 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxBatchSize = 1000
+
+func (s *serverAPI) BatchCreateContacts(
+	ctx context.Context,
+	req *cmv1.BatchCreateContactsRequest,
+) (*cmv1.BatchCreateContactsResponse, error) {
+	if err := validateBatchSize(len(req.GetContacts())); err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	contacts := make([]models.Contact, len(req.GetContacts()))
+	invalid := make([]error, len(req.GetContacts()))
+	for i, item := range req.GetContacts() {
+		contacts[i], invalid[i] = validateCreateContactRequest(item, s.phoneRegion)
+	}
+
+	atomic := req.GetMode() != cmv1.BatchMode_BATCH_MODE_BEST_EFFORT
+	results, err := runBatch(invalid, atomic, "cannot add new contact", func(valid []int) ([]models.BatchResult, error) {
+		batch := make([]models.Contact, len(valid))
+		for j, i := range valid {
+			batch[j] = contacts[i]
+		}
+		return s.cm.BatchCreateContacts(ctx, ownerKey, batch, atomic)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "cannot add new contacts")
+	}
+
+	succeeded, failed := countBatchResults(results)
+	return &cmv1.BatchCreateContactsResponse{Succeeded: succeeded, Failed: failed, Results: results}, nil
+}
+
+func (s *serverAPI) BatchDeleteContacts(
+	ctx context.Context,
+	req *cmv1.BatchDeleteContactsRequest,
+) (*cmv1.BatchDeleteContactsResponse, error) {
+	if err := validateBatchSize(len(req.GetContacts())); err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]models.ContactRef, len(req.GetContacts()))
+	invalid := make([]error, len(req.GetContacts()))
+	for i, item := range req.GetContacts() {
+		refs[i].ID = item.GetId()
+		if item.GetId() <= 0 {
+			invalid[i] = status.Error(codes.InvalidArgument, "id required")
+			continue
+		}
+		refs[i].Version, invalid[i] = parseEtag(item.GetEtag())
+	}
+
+	atomic := req.GetMode() != cmv1.BatchMode_BATCH_MODE_BEST_EFFORT
+	results, err := runBatch(invalid, atomic, "cannot delete contact", func(valid []int) ([]models.BatchResult, error) {
+		batch := make([]models.ContactRef, len(valid))
+		for j, i := range valid {
+			batch[j] = refs[i]
+		}
+		return s.cm.BatchDeleteContacts(ctx, ownerKey, batch, atomic)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "cannot delete contacts")
+	}
+
+	succeeded, failed := countBatchResults(results)
+	return &cmv1.BatchDeleteContactsResponse{Succeeded: succeeded, Failed: failed, Results: results}, nil
+}
+
+func validateBatchSize(n int) error {
+	if n == 0 {
+		return status.Error(codes.InvalidArgument, "contacts required")
+	}
+	if n > maxBatchSize {
+		return status.Errorf(codes.InvalidArgument, "at most %d contacts allowed in a batch", maxBatchSize)
+	}
+	return nil
+}
+
+// Applies valid items of a batch and builds results of all items.
+// invalid holds validation errors by item position. In atomic mode
+// an invalid item fails the batch before anything is applied
+func runBatch(
+	invalid []error,
+	atomic bool,
+	internalMsg string,
+	apply func(valid []int) ([]models.BatchResult, error),
+) ([]*cmv1.BatchResult, error) {
+	results := make([]*cmv1.BatchResult, len(invalid))
+	var valid []int
+	for i, err := range invalid {
+		results[i] = &cmv1.BatchResult{Index: int32(i)}
+		if err != nil {
+			setBatchStatus(results[i], status.Convert(err))
+			continue
+		}
+		valid = append(valid, i)
+	}
+
+	if len(valid) == 0 {
+		return results, nil
+	}
+	if atomic && len(valid) < len(invalid) {
+		for _, i := range valid {
+			setBatchStatus(results[i], batchItemStatus(cm.ErrBatchAborted, internalMsg))
+		}
+		return results, nil
+	}
+
+	applied, err := apply(valid)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range valid {
+		results[i].Id = applied[j].ID
+		if applied[j].Err != nil {
+			setBatchStatus(results[i], batchItemStatus(applied[j].Err, internalMsg))
+		}
+	}
+	return results, nil
+}
+
+func batchItemStatus(err error, internalMsg string) *status.Status {
+	switch {
+	case errors.Is(err, cm.ErrBatchAborted):
+		return status.New(codes.Aborted, "not applied, another item of the batch failed")
+	case errors.Is(err, cm.ErrContactExists):
+		return contactExistsStatus(codes.AlreadyExists, err)
+	case errors.Is(err, cm.ErrContactNotFound):
+		return status.New(codes.NotFound, "contact not found")
+	case errors.Is(err, cm.ErrVersionMismatch):
+		return status.New(codes.Aborted, "contact has been changed, etag mismatch")
+	case errors.Is(err, cm.ErrPermissionDenied):
+		return status.New(codes.PermissionDenied, "contact is shared read-only")
+	}
+	return status.New(codes.Internal, internalMsg)
+}
+
+func setBatchStatus(result *cmv1.BatchResult, st *status.Status) {
+	result.Code = int32(st.Code())
+	result.Error = st.Message()
+}
+
+func countBatchResults(results []*cmv1.BatchResult) (succeeded, failed int32) {
+	for _, result := range results {
+		if result.GetCode() == int32(codes.OK) {
+			succeeded++
+		} else {
+			failed++
+		}
+	}
+	return succeeded, failed
+}
@@ -0,0 +1,56 @@
+package cm
+
+import (
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+)
+
+func TestRunBatchAtomicSkipsStorageOnInvalidItem(t *testing.T) {
+	invalid := []error{nil, status.Error(codes.InvalidArgument, "name required"), nil}
+	results, err := runBatch(invalid, true, "internal", func([]int) ([]models.BatchResult, error) {
+		t.Fatal("invalid batch is applied")
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []codes.Code{codes.Aborted, codes.InvalidArgument, codes.Aborted}
+	for i, result := range results {
+		if codes.Code(result.GetCode()) != want[i] || result.GetIndex() != int32(i) || result.GetId() != 0 {
+			t.Errorf("results[%d] = %v, want code %s", i, result, want[i])
+		}
+	}
+}
+
+func TestRunBatchBestEffortAppliesValidItems(t *testing.T) {
+	invalid := []error{nil, status.Error(codes.InvalidArgument, "name required"), nil, nil}
+	var applied []int
+	results, err := runBatch(invalid, false, "internal", func(valid []int) ([]models.BatchResult, error) {
+		applied = valid
+		return []models.BatchResult{{ID: 10}, {Err: cm.ErrContactExists}, {ID: 12}}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(applied, []int{0, 2, 3}) {
+		t.Errorf("applied items %v, want [0 2 3]", applied)
+	}
+
+	want := []struct {
+		code codes.Code
+		id   int64
+	}{{codes.OK, 10}, {codes.InvalidArgument, 0}, {codes.AlreadyExists, 0}, {codes.OK, 12}}
+	for i, result := range results {
+		if codes.Code(result.GetCode()) != want[i].code || result.GetId() != want[i].id {
+			t.Errorf("results[%d] = %v, want code %s and id %d", i, result, want[i].code, want[i].id)
+		}
+	}
+	if succeeded, failed := countBatchResults(results); succeeded != 2 || failed != 2 {
+		t.Errorf("countBatchResults() = %d, %d, want 2, 2", succeeded, failed)
+	}
+}
@@ -0,0 +1,74 @@
+//go:build sqlite_fts5
+
+package cm
+
+import (
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"testing"
+)
+
+func TestContactEtags(t *testing.T) {
+	s, _, ctx := newTestServer(t)
+	created, err := s.CreateContact(ctx, &cmv1.CreateContactRequest{Name: "Ivan", Email: "ivan@example.com", Phone: "+79123456789"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.GetId()
+	first := versionToEtag(1)
+	nameMask := &fieldmaskpb.FieldMask{Paths: []string{"name"}}
+
+	updated, err := s.UpdateContact(ctx, &cmv1.UpdateContactRequest{Id: id, Name: "Ivan Petrov", Etag: first, UpdateMask: nameMask})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetEtag() == first {
+		t.Errorf("etag %s did not change on update", first)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{"update with stale etag", func() error {
+			_, err := s.UpdateContact(ctx, &cmv1.UpdateContactRequest{Id: id, Name: "Ivan", Etag: first, UpdateMask: nameMask})
+			return err
+		}, codes.Aborted},
+		{"tag with stale etag", func() error {
+			_, err := s.AddContactTags(ctx, &cmv1.AddContactTagsRequest{ContactId: id, Tags: []string{"friends"}, Etag: first})
+			return err
+		}, codes.Aborted},
+		{"delete with stale etag", func() error {
+			_, err := s.DeleteContact(ctx, &cmv1.DeleteContactRequest{Id: id, Etag: first})
+			return err
+		}, codes.Aborted},
+		{"delete with malformed etag", func() error {
+			_, err := s.DeleteContact(ctx, &cmv1.DeleteContactRequest{Id: id, Etag: "1"})
+			return err
+		}, codes.InvalidArgument},
+		{"update of missing contact", func() error {
+			_, err := s.UpdateContact(ctx, &cmv1.UpdateContactRequest{Id: id + 100, Name: "Ivan", Etag: first, UpdateMask: nameMask})
+			return err
+		}, codes.NotFound},
+		{"delete of missing contact", func() error {
+			_, err := s.DeleteContact(ctx, &cmv1.DeleteContactRequest{Id: id + 100})
+			return err
+		}, codes.NotFound},
+		{"delete with current etag", func() error {
+			_, err := s.DeleteContact(ctx, &cmv1.DeleteContactRequest{Id: id, Etag: updated.GetEtag()})
+			return err
+		}, codes.OK},
+		{"restore with etag before delete", func() error {
+			_, err := s.RestoreContact(ctx, &cmv1.RestoreContactRequest{Id: id, Etag: updated.GetEtag()})
+			return err
+		}, codes.Aborted},
+	}
+	for _, tt := range tests {
+		if err := tt.call(); status.Code(err) != tt.want {
+			t.Errorf("%s: error = %v, want %s", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package cm
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/contactcsv"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"strings"
+)
+
+const csvChunkSize = 32 << 10
+
+var csvProfiles = map[cmv1.CSVProfile]contactcsv.Mapping{
+	cmv1.CSVProfile_CSV_PROFILE_UNSPECIFIED: contactcsv.Default,
+	cmv1.CSVProfile_CSV_PROFILE_GOOGLE:      contactcsv.Google,
+	cmv1.CSVProfile_CSV_PROFILE_OUTLOOK:     contactcsv.Outlook,
+}
+
+func (s *serverAPI) ExportContactsCSV(
+	req *cmv1.ExportContactsCSVRequest,
+	stream cmv1.ContactManager_ExportContactsCSVServer,
+) error {
+	if _, err := csvMappingFor(req.GetProfile(), req.GetMapping()); err != nil {
+		return err
+	}
+	filter, err := validateContactFilter(req.GetGroupId(), req.GetTag())
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(&chunkWriter{send: func(chunk []byte) error {
+		return stream.Send(&cmv1.ExportContactsCSVResponse{Chunk: chunk})
+	}}, csvChunkSize)
+	err = ExportCSV(ctx, s.cm, ownerKey, w, req.GetProfile(), req.GetMapping(), filter)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Error(codes.Internal, "cannot export contacts")
+	}
+	return nil
+}
+
+func (s *serverAPI) ImportContactsCSV(stream cmv1.ContactManager_ImportContactsCSVServer) error {
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "CSV data required")
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	ownerKey, err := s.addressBook(ctx, first.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	r := &chunkReader{buf: first.GetChunk(), recv: func() ([]byte, error) {
+		req, err := stream.Recv()
+		return req.GetChunk(), err
+	}}
+	resp, err := ImportCSV(
+		ctx,
+		s.cm,
+		ownerKey,
+		r,
+		first.GetProfile(),
+		first.GetMapping(),
+		first.GetDryRun(),
+		s.phoneRegion,
+	)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// ExportCSV writes owner's contacts matching filter to w as CSV with columns of the profile
+// or custom mapping. Values that do not fit into the columns are dropped
+func ExportCSV(
+	ctx context.Context,
+	contacts ContactManager,
+	ownerKey string,
+	w io.Writer,
+	profile cmv1.CSVProfile,
+	mapping *cmv1.CSVMapping,
+	filter models.ContactFilter,
+) error {
+	m, err := csvMappingFor(profile, mapping)
+	if err != nil {
+		return err
+	}
+
+	cw := contactcsv.NewWriter(w, m)
+	if err = contacts.ExportContacts(ctx, ownerKey, filter, cw.Write); err != nil {
+		return err
+	}
+	return cw.Flush()
+}
+
+// ImportCSV reads contacts from CSV with header in the first line and validates every row
+// with the same rules as CreateContact. Valid rows are saved unless dryRun is set.
+// Rows are reported one by one in the response up to maxImportResults, errors are returned only for
+// unusable mapping or header and for failures of r
+func ImportCSV(
+	ctx context.Context,
+	contacts ContactManager,
+	ownerKey string,
+	r io.Reader,
+	profile cmv1.CSVProfile,
+	mapping *cmv1.CSVMapping,
+	dryRun bool,
+	phoneRegion string,
+) (*cmv1.ImportContactsResponse, error) {
+	m, err := csvMappingFor(profile, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	cr, err := contactcsv.NewReader(r, m)
+	var parseErr *csv.ParseError
+	switch {
+	case errors.Is(err, contactcsv.ErrNoData), errors.Is(err, contactcsv.ErrNoNameColumn):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &parseErr):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid CSV header: %v", parseErr)
+	case err != nil:
+		return nil, err
+	}
+
+	resp := &cmv1.ImportContactsResponse{}
+	for index := int32(0); ; index++ {
+		row, err := cr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		result := &cmv1.ImportResult{Index: index}
+		switch {
+		case errors.As(err, &parseErr):
+			result.Error = parseErr.Error()
+		case err != nil:
+			return nil, err
+		default:
+			result.Id, result.Name, result.Error = importCSVRow(ctx, contacts, ownerKey, row, dryRun, phoneRegion)
+		}
+
+		addImportResult(resp, result)
+	}
+	return resp, nil
+}
+
+// Returns the reason the row was not imported instead of error
+func importCSVRow(
+	ctx context.Context,
+	contacts ContactManager,
+	ownerKey string,
+	row contactcsv.Row,
+	dryRun bool,
+	phoneRegion string,
+) (id int64, name string, reason string) {
+	req := &cmv1.CreateContactRequest{Name: row.Name}
+	for _, e := range row.Emails {
+		req.Emails = append(req.Emails, &cmv1.LabeledEmail{Email: e.Email, Label: labelsToProto[e.Label]})
+	}
+	for _, p := range row.Phones {
+		req.Phones = append(req.Phones, &cmv1.LabeledPhone{Phone: p.Phone, Label: labelsToProto[p.Label]})
+	}
+
+	contact, err := validateCreateContactRequest(req, phoneRegion)
+	if err != nil {
+		return 0, req.GetName(), status.Convert(err).Message()
+	}
+	var tags []string
+	if len(row.Tags) > 0 {
+		if tags, err = validateTags(row.Tags); err != nil {
+			return 0, contact.Name, status.Convert(err).Message()
+		}
+	}
+	if dryRun {
+		return 0, contact.Name, ""
+	}
+
+	id, err = contacts.CreateContact(ctx, ownerKey, contact)
+	if err != nil {
+		if errors.Is(err, cm.ErrContactExists) {
+			return 0, contact.Name, contactExistsMessage(err)
+		}
+		return 0, contact.Name, "cannot add new contact"
+	}
+	if len(tags) > 0 {
+		if err = contacts.AddContactTags(ctx, ownerKey, id, 0, tags); err != nil {
+			return id, contact.Name, "contact created, but cannot tag it"
+		}
+	}
+	return id, contact.Name, ""
+}
+
+// Returns built-in profile or validated custom mapping when it is set
+func csvMappingFor(profile cmv1.CSVProfile, mapping *cmv1.CSVMapping) (contactcsv.Mapping, error) {
+	if mapping == nil {
+		m, ok := csvProfiles[profile]
+		if !ok {
+			return contactcsv.Mapping{}, status.Error(codes.InvalidArgument, "unknown CSV profile")
+		}
+		return m, nil
+	}
+
+	m := contactcsv.Mapping{
+		Tags:         strings.TrimSpace(mapping.GetTagsColumn()),
+		TagSeparator: mapping.GetTagsSeparator(),
+	}
+	for _, c := range mapping.GetNameColumns() {
+		if c = strings.TrimSpace(c); c != "" {
+			m.Name = append(m.Name, c)
+		}
+	}
+	if len(m.Name) == 0 {
+		return contactcsv.Mapping{}, status.Error(codes.InvalidArgument, "mapping.name_columns required")
+	}
+
+	var err error
+	if m.Emails, err = csvFieldsFromProto(mapping.GetEmails()); err != nil {
+		return contactcsv.Mapping{}, err
+	}
+	if m.Phones, err = csvFieldsFromProto(mapping.GetPhones()); err != nil {
+		return contactcsv.Mapping{}, err
+	}
+	return m, nil
+}
+
+func csvFieldsFromProto(fields []*cmv1.CSVField) ([]contactcsv.Field, error) {
+	res := make([]contactcsv.Field, 0, len(fields))
+	for _, f := range fields {
+		column := strings.TrimSpace(f.GetColumn())
+		if column == "" {
+			return nil, status.Error(codes.InvalidArgument, "mapping column required")
+		}
+		label, ok := labelsFromProto[f.GetLabel()]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown mapping label")
+		}
+		res = append(res, contactcsv.Field{
+			Column:      column,
+			LabelColumn: strings.TrimSpace(f.GetLabelColumn()),
+			Label:       label,
+		})
+	}
+	return res, nil
+}
+
+// Sends written data as chunks of export stream
+type chunkWriter struct {
+	send func([]byte) error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	if err := w.send(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
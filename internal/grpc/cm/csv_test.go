@@ -0,0 +1,55 @@
+package cm
+
+import (
+	"context"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"testing"
+)
+
+func TestImportCSVDryRunReportsRows(t *testing.T) {
+	data := "Name,Email 1 - Value,Phone 1 - Value,Tags\n" +
+		"Ivan,ivan@example.com,+7 912 345-67-89,friends\n" +
+		",anna@example.com,+79123456780,\n" +
+		"Olga,not an email,+79123456781,\n" +
+		"Petr,petr@example.com,,\n" +
+		"Oleg,oleg@example.com,+79123456782,\n"
+
+	// dry run must not touch the service, so there is none
+	resp, err := ImportCSV(context.Background(), nil, "user:1", strings.NewReader(data),
+		cmv1.CSVProfile_CSV_PROFILE_UNSPECIFIED, nil, true, "RU")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetImported() != 2 || resp.GetFailed() != 3 {
+		t.Errorf("imported = %d, failed = %d, want 2 and 3", resp.GetImported(), resp.GetFailed())
+	}
+
+	want := []struct {
+		name string
+		ok   bool
+	}{{"Ivan", true}, {"", false}, {"Olga", false}, {"Petr", false}, {"Oleg", true}}
+	if len(resp.GetResults()) != len(want) {
+		t.Fatalf("results = %v", resp.GetResults())
+	}
+	for i, result := range resp.GetResults() {
+		if result.GetIndex() != int32(i) || result.GetName() != want[i].name || (result.GetError() == "") != want[i].ok {
+			t.Errorf("results[%d] = %v, want name %q and ok %v", i, result, want[i].name, want[i].ok)
+		}
+		if result.GetId() != 0 {
+			t.Errorf("results[%d] has id %d in dry run", i, result.GetId())
+		}
+	}
+}
+
+func TestImportCSVRejectsHeader(t *testing.T) {
+	for _, data := range []string{"", "Email 1 - Value\nivan@example.com\n"} {
+		_, err := ImportCSV(context.Background(), nil, "user:1", strings.NewReader(data),
+			cmv1.CSVProfile_CSV_PROFILE_UNSPECIFIED, nil, true, "RU")
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ImportCSV(%q) error = %v, want InvalidArgument", data, err)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxMergedContacts = 100
+
+var duplicateReasons = map[models.DuplicateReason]cmv1.DuplicateReason{
+	models.DuplicateEmail: cmv1.DuplicateReason_DUPLICATE_REASON_EMAIL,
+	models.DuplicatePhone: cmv1.DuplicateReason_DUPLICATE_REASON_PHONE,
+	models.DuplicateName:  cmv1.DuplicateReason_DUPLICATE_REASON_NAME,
+}
+
+func (s *serverAPI) FindDuplicates(
+	ctx context.Context,
+	req *cmv1.FindDuplicatesRequest,
+) (*cmv1.FindDuplicatesResponse, error) {
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters, err := s.cm.FindDuplicates(ctx, ownerKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "cannot find duplicates")
+	}
+
+	resp := &cmv1.FindDuplicatesResponse{
+		Clusters: make([]*cmv1.DuplicateCluster, 0, len(clusters)),
+	}
+	for _, cluster := range clusters {
+		c := &cmv1.DuplicateCluster{
+			Contacts: make([]*cmv1.Contact, 0, len(cluster.Contacts)),
+		}
+		for _, contact := range cluster.Contacts {
+			c.Contacts = append(c.Contacts, contactToProto(contact))
+		}
+		for _, reason := range cluster.Reasons {
+			c.Reasons = append(c.Reasons, duplicateReasons[reason])
+		}
+		resp.Clusters = append(resp.Clusters, c)
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) MergeContacts(
+	ctx context.Context,
+	req *cmv1.MergeContactsRequest,
+) (*cmv1.MergeContactsResponse, error) {
+	if err := validateMergeContactsRequest(req); err != nil {
+		return nil, err
+	}
+	version, err := parseEtag(req.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, mergeID, err := s.cm.MergeContacts(ctx, ownerKey, req.GetSurvivorId(), version, req.GetMergedIds())
+	if err != nil {
+		if errors.Is(err, cm.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "contact not found")
+		}
+		if errors.Is(err, cm.ErrVersionMismatch) {
+			return nil, status.Error(codes.Aborted, "contact has been changed, etag mismatch")
+		}
+		if errors.Is(err, cm.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, "only the owner can merge contacts")
+		}
+		if errors.Is(err, cm.ErrContactExists) {
+			return nil, contactExistsStatus(codes.AlreadyExists, err).Err()
+		}
+		return nil, status.Error(codes.Internal, "cannot merge contacts")
+	}
+	return &cmv1.MergeContactsResponse{MergeId: mergeID, Contact: contactToProto(contact)}, nil
+}
+
+func (s *serverAPI) UndoMergeContacts(
+	ctx context.Context,
+	req *cmv1.UndoMergeContactsRequest,
+) (*cmv1.UndoMergeContactsResponse, error) {
+	if req.GetMergeId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "merge_id required")
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, restored, err := s.cm.UndoMerge(ctx, ownerKey, req.GetMergeId())
+	if err != nil {
+		if errors.Is(err, cm.ErrMergeNotFound) {
+			return nil, status.Error(codes.NotFound, "merge not found or already undone")
+		}
+		if errors.Is(err, cm.ErrContactNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "contacts of the merge are no longer available")
+		}
+		if errors.Is(err, cm.ErrVersionMismatch) {
+			return nil, status.Error(codes.FailedPrecondition, "contact has been changed since the merge")
+		}
+		if errors.Is(err, cm.ErrContactExists) {
+			return nil, contactExistsStatus(codes.AlreadyExists, err).Err()
+		}
+		return nil, status.Error(codes.Internal, "cannot undo merge")
+	}
+	return &cmv1.UndoMergeContactsResponse{Contact: contactToProto(contact), RestoredIds: restored}, nil
+}
+
+func validateMergeContactsRequest(req *cmv1.MergeContactsRequest) error {
+	if req.GetSurvivorId() <= 0 {
+		return status.Error(codes.InvalidArgument, "survivor_id required")
+	}
+	if len(req.GetMergedIds()) == 0 {
+		return status.Error(codes.InvalidArgument, "merged_ids required")
+	}
+	if len(req.GetMergedIds()) > maxMergedContacts {
+		return status.Errorf(codes.InvalidArgument, "at most %d contacts can be merged at once", maxMergedContacts)
+	}
+
+	seen := map[int64]bool{req.GetSurvivorId(): true}
+	for _, id := range req.GetMergedIds() {
+		if id <= 0 {
+			return status.Error(codes.InvalidArgument, "invalid contact id")
+		}
+		if seen[id] {
+			return status.Error(codes.InvalidArgument, "merged_ids must be distinct and must not contain survivor_id")
+		}
+		seen[id] = true
+	}
+	return nil
+}
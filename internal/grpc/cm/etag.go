@@ -0,0 +1,29 @@
+package cm
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+)
+
+// ETags are opaque for clients, currently they are contact versions in quotes
+func versionToEtag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// Returns version of the contact the client has read.
+// Empty etag gives zero version, so the change is unconditional
+func parseEtag(etag string) (int64, error) {
+	if etag == "" {
+		return 0, nil
+	}
+	s, err := strconv.Unquote(etag)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "invalid etag")
+	}
+	version, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, status.Error(codes.InvalidArgument, "invalid etag")
+	}
+	return version, nil
+}
@@ -0,0 +1,36 @@
+package cm
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestParseEtag(t *testing.T) {
+	tests := []struct {
+		etag    string
+		want    int64
+		wantErr bool
+	}{
+		{"", 0, false},
+		{versionToEtag(7), 7, false},
+		{`"12"`, 12, false},
+		{"12", 0, true},
+		{`"0"`, 0, true},
+		{`"-3"`, 0, true},
+		{`"v1"`, 0, true},
+		{`"12`, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseEtag(tt.etag)
+		if tt.wantErr {
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("parseEtag(%q) error = %v, want InvalidArgument", tt.etag, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("parseEtag(%q) = %d, %v, want %d", tt.etag, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxGroupNameLength = 100
+	maxTagLength       = 64
+)
+
+func (s *serverAPI) CreateGroup(
+	ctx context.Context,
+	req *cmv1.CreateGroupRequest,
+) (*cmv1.CreateGroupResponse, error) {
+	name, err := validateGroupName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.cm.CreateGroup(ctx, ownerKey, name)
+	if err != nil {
+		if errors.Is(err, cm.ErrGroupExists) {
+			return nil, status.Error(codes.AlreadyExists, "group already exists")
+		}
+		return nil, status.Error(codes.Internal, "cannot create group")
+	}
+	return &cmv1.CreateGroupResponse{Id: id, Success: true}, nil
+}
+
+func (s *serverAPI) RenameGroup(
+	ctx context.Context,
+	req *cmv1.RenameGroupRequest,
+) (*cmv1.RenameGroupResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id required")
+	}
+	name, err := validateGroupName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cm.RenameGroup(ctx, ownerKey, req.GetId(), name)
+	if err != nil {
+		if errors.Is(err, cm.ErrGroupNotFound) {
+			return nil, status.Error(codes.NotFound, "group not found")
+		}
+		if errors.Is(err, cm.ErrGroupExists) {
+			return nil, status.Error(codes.AlreadyExists, "group already exists")
+		}
+		return nil, status.Error(codes.Internal, "cannot rename group")
+	}
+	return &cmv1.RenameGroupResponse{Success: true}, nil
+}
+
+func (s *serverAPI) DeleteGroup(
+	ctx context.Context,
+	req *cmv1.DeleteGroupRequest,
+) (*cmv1.DeleteGroupResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id required")
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cm.DeleteGroup(ctx, ownerKey, req.GetId())
+	if err != nil {
+		if errors.Is(err, cm.ErrGroupNotFound) {
+			return nil, status.Error(codes.NotFound, "group not found")
+		}
+		return nil, status.Error(codes.Internal, "cannot delete group")
+	}
+	return &cmv1.DeleteGroupResponse{Success: true}, nil
+}
+
+func (s *serverAPI) ListGroups(
+	ctx context.Context,
+	req *cmv1.ListGroupsRequest,
+) (*cmv1.ListGroupsResponse, error) {
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.cm.ListGroups(ctx, ownerKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "cannot list groups")
+	}
+
+	resp := &cmv1.ListGroupsResponse{
+		Groups: make([]*cmv1.Group, 0, len(groups)),
+	}
+	for _, group := range groups {
+		resp.Groups = append(resp.Groups, &cmv1.Group{
+			Id:           group.ID,
+			Name:         group.Name,
+			CreatedAt:    group.CreatedAt.Unix(),
+			MembersCount: group.MembersCount,
+		})
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) AddGroupMembers(
+	ctx context.Context,
+	req *cmv1.AddGroupMembersRequest,
+) (*cmv1.AddGroupMembersResponse, error) {
+	if err := validateGroupMembers(req.GetGroupId(), req.GetContactIds()); err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cm.AddGroupMembers(ctx, ownerKey, req.GetGroupId(), req.GetContactIds())
+	if err != nil {
+		if errors.Is(err, cm.ErrGroupNotFound) {
+			return nil, status.Error(codes.NotFound, "group not found")
+		}
+		if errors.Is(err, cm.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "contact not found")
+		}
+		return nil, status.Error(codes.Internal, "cannot add group members")
+	}
+	return &cmv1.AddGroupMembersResponse{Success: true}, nil
+}
+
+func (s *serverAPI) RemoveGroupMembers(
+	ctx context.Context,
+	req *cmv1.RemoveGroupMembersRequest,
+) (*cmv1.RemoveGroupMembersResponse, error) {
+	if err := validateGroupMembers(req.GetGroupId(), req.GetContactIds()); err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cm.RemoveGroupMembers(ctx, ownerKey, req.GetGroupId(), req.GetContactIds())
+	if err != nil {
+		if errors.Is(err, cm.ErrGroupNotFound) {
+			return nil, status.Error(codes.NotFound, "group not found")
+		}
+		return nil, status.Error(codes.Internal, "cannot remove group members")
+	}
+	return &cmv1.RemoveGroupMembersResponse{Success: true}, nil
+}
+
+func (s *serverAPI) AddContactTags(
+	ctx context.Context,
+	req *cmv1.AddContactTagsRequest,
+) (*cmv1.AddContactTagsResponse, error) {
+	if req.GetContactId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "contact_id required")
+	}
+	tags, err := validateTags(req.GetTags())
+	if err != nil {
+		return nil, err
+	}
+	version, err := parseEtag(req.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cm.AddContactTags(ctx, ownerKey, req.GetContactId(), version, tags)
+	if err != nil {
+		if errors.Is(err, cm.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "contact not found")
+		}
+		if errors.Is(err, cm.ErrVersionMismatch) {
+			return nil, status.Error(codes.Aborted, "contact has been changed, etag mismatch")
+		}
+		if errors.Is(err, cm.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, "contact is shared read-only")
+		}
+		return nil, status.Error(codes.Internal, "cannot tag contact")
+	}
+	return &cmv1.AddContactTagsResponse{Success: true}, nil
+}
+
+func (s *serverAPI) RemoveContactTags(
+	ctx context.Context,
+	req *cmv1.RemoveContactTagsRequest,
+) (*cmv1.RemoveContactTagsResponse, error) {
+	if req.GetContactId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "contact_id required")
+	}
+	tags, err := validateTags(req.GetTags())
+	if err != nil {
+		return nil, err
+	}
+	version, err := parseEtag(req.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cm.RemoveContactTags(ctx, ownerKey, req.GetContactId(), version, tags)
+	if err != nil {
+		if errors.Is(err, cm.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "contact not found")
+		}
+		if errors.Is(err, cm.ErrVersionMismatch) {
+			return nil, status.Error(codes.Aborted, "contact has been changed, etag mismatch")
+		}
+		if errors.Is(err, cm.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, "contact is shared read-only")
+		}
+		return nil, status.Error(codes.Internal, "cannot untag contact")
+	}
+	return &cmv1.RemoveContactTagsResponse{Success: true}, nil
+}
+
+func validateGroupName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", status.Error(codes.InvalidArgument, "name required")
+	}
+	if utf8.RuneCountInString(name) > maxGroupNameLength {
+		return "", status.Error(codes.InvalidArgument, "name is too long")
+	}
+	return name, nil
+}
+
+func validateGroupMembers(groupID int64, contactIDs []int64) error {
+	if groupID <= 0 {
+		return status.Error(codes.InvalidArgument, "group_id required")
+	}
+	if len(contactIDs) == 0 {
+		return status.Error(codes.InvalidArgument, "contact_ids required")
+	}
+	for _, id := range contactIDs {
+		if id <= 0 {
+			return status.Error(codes.InvalidArgument, "invalid contact id")
+		}
+	}
+	return nil
+}
+
+// Trims tags and drops duplicates
+func validateTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tags required")
+	}
+
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			return nil, status.Error(codes.InvalidArgument, "tag must not be empty")
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, status.Errorf(codes.InvalidArgument, "tag %q is too long", tag)
+		}
+		if seen[strings.ToLower(tag)] {
+			continue
+		}
+		seen[strings.ToLower(tag)] = true
+		res = append(res, tag)
+	}
+	return res, nil
+}
+
+func validateContactFilter(groupID int64, tag string) (models.ContactFilter, error) {
+	if groupID < 0 {
+		return models.ContactFilter{}, status.Error(codes.InvalidArgument, "invalid group_id")
+	}
+	return models.ContactFilter{GroupID: groupID, Tag: strings.TrimSpace(tag)}, nil
+}
@@ -0,0 +1,176 @@
+package cm
+
+import (
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var labelsFromProto = map[cmv1.Label]models.Label{
+	cmv1.Label_LABEL_UNSPECIFIED: models.LabelOther,
+	cmv1.Label_LABEL_WORK:        models.LabelWork,
+	cmv1.Label_LABEL_HOME:        models.LabelHome,
+	cmv1.Label_LABEL_MOBILE:      models.LabelMobile,
+	cmv1.Label_LABEL_OTHER:       models.LabelOther,
+}
+
+var labelsToProto = map[models.Label]cmv1.Label{
+	models.LabelWork:   cmv1.Label_LABEL_WORK,
+	models.LabelHome:   cmv1.Label_LABEL_HOME,
+	models.LabelMobile: cmv1.Label_LABEL_MOBILE,
+	models.LabelOther:  cmv1.Label_LABEL_OTHER,
+}
+
+// Validates labeled emails and merges primary email into them.
+// If no email is marked as primary, the first one becomes primary
+func emailsFromProto(primary string, list []*cmv1.LabeledEmail) ([]models.ContactEmail, error) {
+	values, err := labeledFromProto("email", primary, list, (*cmv1.LabeledEmail).GetEmail, func(email string) (string, error) {
+		return email, validateEmail(email)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make([]models.ContactEmail, 0, len(values))
+	for _, v := range values {
+		emails = append(emails, models.ContactEmail{Email: v.value, Label: v.label, Primary: v.primary})
+	}
+	return emails, nil
+}
+
+// Validates labeled phones, normalizes them to E.164 and merges primary phone into them.
+// If no phone is marked as primary, the first one becomes primary
+func phonesFromProto(primary string, list []*cmv1.LabeledPhone, phoneRegion string) ([]models.ContactPhone, error) {
+	values, err := labeledFromProto("phone", primary, list, (*cmv1.LabeledPhone).GetPhone, func(phone string) (string, error) {
+		return validatePhone(phone, phoneRegion)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	phones := make([]models.ContactPhone, 0, len(values))
+	for _, v := range values {
+		phones = append(phones, models.ContactPhone{Phone: v.value, Label: v.label, Primary: v.primary})
+	}
+	return phones, nil
+}
+
+type labeledProto interface {
+	GetLabel() cmv1.Label
+	GetPrimary() bool
+}
+
+type labeledValue struct {
+	value   string
+	label   models.Label
+	primary bool
+}
+
+// Validates labeled values of one kind (email or phone) with normalize, which returns
+// the value to store, and merges primary value into them.
+// If no value is marked as primary, the first one becomes primary
+func labeledFromProto[P labeledProto](
+	kind string,
+	primary string,
+	list []P,
+	value func(P) string,
+	normalize func(string) (string, error),
+) ([]labeledValue, error) {
+	values := make([]labeledValue, 0, len(list)+1)
+	seen := make(map[string]bool, len(list)+1)
+	primaries := 0
+	for _, p := range list {
+		v, err := normalize(value(p))
+		if err != nil {
+			return nil, err
+		}
+		if seen[v] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate %s %q", kind, value(p))
+		}
+		seen[v] = true
+
+		label, ok := labelsFromProto[p.GetLabel()]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown %s label", kind)
+		}
+		if p.GetPrimary() {
+			primaries++
+		}
+		values = append(values, labeledValue{value: v, label: label, primary: p.GetPrimary()})
+	}
+	if primaries > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "only one %s can be primary", kind)
+	}
+
+	if primary != "" {
+		normalized, err := normalize(primary)
+		if err != nil {
+			return nil, err
+		}
+		primary = normalized
+		differs := status.Errorf(codes.InvalidArgument, "%s differs from primary %s in %ss", kind, kind, kind)
+		if !seen[primary] {
+			if primaries > 0 {
+				return nil, differs
+			}
+			values = append([]labeledValue{{value: primary, label: models.LabelOther}}, values...)
+		}
+		for i := range values {
+			if values[i].value == primary {
+				if primaries > 0 && !values[i].primary {
+					return nil, differs
+				}
+				values[i].primary = true
+			}
+		}
+		primaries = 1
+	}
+
+	if primaries == 0 && len(values) > 0 {
+		values[0].primary = true
+	}
+	return values, nil
+}
+
+func primaryEmail(emails []models.ContactEmail) string {
+	for _, e := range emails {
+		if e.Primary {
+			return e.Email
+		}
+	}
+	return ""
+}
+
+func primaryPhone(phones []models.ContactPhone) string {
+	for _, p := range phones {
+		if p.Primary {
+			return p.Phone
+		}
+	}
+	return ""
+}
+
+func emailsToProto(emails []models.ContactEmail) []*cmv1.LabeledEmail {
+	res := make([]*cmv1.LabeledEmail, 0, len(emails))
+	for _, e := range emails {
+		res = append(res, &cmv1.LabeledEmail{
+			Email:   e.Email,
+			Label:   labelsToProto[e.Label],
+			Primary: e.Primary,
+		})
+	}
+	return res
+}
+
+func phonesToProto(phones []models.ContactPhone) []*cmv1.LabeledPhone {
+	res := make([]*cmv1.LabeledPhone, 0, len(phones))
+	for _, p := range phones {
+		res = append(res, &cmv1.LabeledPhone{
+			Phone:   p.Phone,
+			Label:   labelsToProto[p.Label],
+			Primary: p.Primary,
+		})
+	}
+	return res
+}
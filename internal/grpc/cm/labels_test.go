@@ -0,0 +1,111 @@
+package cm
+
+import (
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+)
+
+func TestEmailsFromProto(t *testing.T) {
+	tests := []struct {
+		name    string
+		primary string
+		list    []*cmv1.LabeledEmail
+		want    []models.ContactEmail
+		wantErr string
+	}{
+		{
+			name: "first is primary",
+			list: []*cmv1.LabeledEmail{
+				{Email: "a@example.com", Label: cmv1.Label_LABEL_WORK},
+				{Email: "b@example.com"},
+			},
+			want: []models.ContactEmail{
+				{Email: "a@example.com", Label: models.LabelWork, Primary: true},
+				{Email: "b@example.com", Label: models.LabelOther},
+			},
+		},
+		{
+			name:    "primary added first",
+			primary: "p@example.com",
+			list:    []*cmv1.LabeledEmail{{Email: "a@example.com", Label: cmv1.Label_LABEL_HOME}},
+			want: []models.ContactEmail{
+				{Email: "p@example.com", Label: models.LabelOther, Primary: true},
+				{Email: "a@example.com", Label: models.LabelHome},
+			},
+		},
+		{
+			name:    "primary in list",
+			primary: "b@example.com",
+			list:    []*cmv1.LabeledEmail{{Email: "a@example.com"}, {Email: "b@example.com", Label: cmv1.Label_LABEL_WORK}},
+			want: []models.ContactEmail{
+				{Email: "a@example.com", Label: models.LabelOther},
+				{Email: "b@example.com", Label: models.LabelWork, Primary: true},
+			},
+		},
+		{
+			name:    "duplicate",
+			list:    []*cmv1.LabeledEmail{{Email: "a@example.com"}, {Email: "a@example.com"}},
+			wantErr: `duplicate email "a@example.com"`,
+		},
+		{
+			name:    "two primaries",
+			list:    []*cmv1.LabeledEmail{{Email: "a@example.com", Primary: true}, {Email: "b@example.com", Primary: true}},
+			wantErr: "only one email can be primary",
+		},
+		{
+			name:    "primary differs",
+			primary: "b@example.com",
+			list:    []*cmv1.LabeledEmail{{Email: "a@example.com", Primary: true}, {Email: "b@example.com"}},
+			wantErr: "email differs from primary email in emails",
+		},
+		{
+			name:    "unknown label",
+			list:    []*cmv1.LabeledEmail{{Email: "a@example.com", Label: cmv1.Label(42)}},
+			wantErr: "unknown email label",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := emailsFromProto(tt.primary, tt.list)
+			if tt.wantErr != "" {
+				if status.Code(err) != codes.InvalidArgument || status.Convert(err).Message() != tt.wantErr {
+					t.Fatalf("emailsFromProto() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("emailsFromProto() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("emailsFromProto() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPhonesFromProto(t *testing.T) {
+	got, err := phonesFromProto("8 (912) 345-67-89", []*cmv1.LabeledPhone{
+		{Phone: "+7 495 123-45-67", Label: cmv1.Label_LABEL_WORK},
+		{Phone: "+79123456789", Label: cmv1.Label_LABEL_MOBILE},
+	}, "RU")
+	if err != nil {
+		t.Fatalf("phonesFromProto() error = %v", err)
+	}
+	want := []models.ContactPhone{
+		{Phone: "+74951234567", Label: models.LabelWork},
+		{Phone: "+79123456789", Label: models.LabelMobile, Primary: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("phonesFromProto() = %+v, want %+v", got, want)
+	}
+
+	// the same number written differently is a duplicate
+	_, err = phonesFromProto("", []*cmv1.LabeledPhone{{Phone: "+79123456789"}, {Phone: "8 912 345 67 89"}}, "RU")
+	if status.Convert(err).Message() != `duplicate phone "8 912 345 67 89"` {
+		t.Errorf("duplicate: error = %v", err)
+	}
+}
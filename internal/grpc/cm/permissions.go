@@ -0,0 +1,68 @@
+package cm
+
+import (
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+)
+
+// MethodPermissions is the permission each RPC requires. Roles in address books
+// are checked by the handlers on top of it. A method missing here is denied to everyone
+var MethodPermissions = map[string]auth.Permission{
+	// contacts
+	cmv1.ContactManager_CreateContact_FullMethodName:       auth.PermWrite,
+	cmv1.ContactManager_GetContactByEmail_FullMethodName:   auth.PermRead,
+	cmv1.ContactManager_GetContactByName_FullMethodName:    auth.PermRead,
+	cmv1.ContactManager_GetContactByPhone_FullMethodName:   auth.PermRead,
+	cmv1.ContactManager_UpdateContact_FullMethodName:       auth.PermWrite,
+	cmv1.ContactManager_DeleteContact_FullMethodName:       auth.PermWrite,
+	cmv1.ContactManager_ListContacts_FullMethodName:        auth.PermRead,
+	cmv1.ContactManager_SearchContacts_FullMethodName:      auth.PermRead,
+	cmv1.ContactManager_FuzzySearchContacts_FullMethodName: auth.PermRead,
+	cmv1.ContactManager_BatchCreateContacts_FullMethodName: auth.PermWrite,
+	cmv1.ContactManager_BatchDeleteContacts_FullMethodName: auth.PermWrite,
+	cmv1.ContactManager_AddContactTags_FullMethodName:      auth.PermWrite,
+	cmv1.ContactManager_RemoveContactTags_FullMethodName:   auth.PermWrite,
+
+	// import and export
+	cmv1.ContactManager_ExportContacts_FullMethodName:    auth.PermRead,
+	cmv1.ContactManager_ImportContacts_FullMethodName:    auth.PermWrite,
+	cmv1.ContactManager_ExportContactsCSV_FullMethodName: auth.PermRead,
+	cmv1.ContactManager_ImportContactsCSV_FullMethodName: auth.PermWrite,
+
+	// groups
+	cmv1.ContactManager_CreateGroup_FullMethodName:        auth.PermWrite,
+	cmv1.ContactManager_RenameGroup_FullMethodName:        auth.PermWrite,
+	cmv1.ContactManager_DeleteGroup_FullMethodName:        auth.PermWrite,
+	cmv1.ContactManager_ListGroups_FullMethodName:         auth.PermRead,
+	cmv1.ContactManager_AddGroupMembers_FullMethodName:    auth.PermWrite,
+	cmv1.ContactManager_RemoveGroupMembers_FullMethodName: auth.PermWrite,
+
+	// trash and history
+	cmv1.ContactManager_ListDeletedContacts_FullMethodName:    auth.PermRead,
+	cmv1.ContactManager_RestoreContact_FullMethodName:         auth.PermWrite,
+	cmv1.ContactManager_PurgeContact_FullMethodName:           auth.PermWrite,
+	cmv1.ContactManager_ListContactRevisions_FullMethodName:   auth.PermRead,
+	cmv1.ContactManager_RestoreContactRevision_FullMethodName: auth.PermWrite,
+	cmv1.ContactManager_FindDuplicates_FullMethodName:         auth.PermRead,
+	cmv1.ContactManager_MergeContacts_FullMethodName:          auth.PermWrite,
+	cmv1.ContactManager_UndoMergeContacts_FullMethodName:      auth.PermWrite,
+	cmv1.ContactManager_GetUniquenessPolicy_FullMethodName:    auth.PermRead,
+	cmv1.ContactManager_SetUniquenessPolicy_FullMethodName:    auth.PermWrite,
+
+	// sync
+	cmv1.ContactManager_SyncContacts_FullMethodName:  auth.PermRead,
+	cmv1.ContactManager_WatchContacts_FullMethodName: auth.PermRead,
+
+	// sharing
+	cmv1.ContactManager_ShareContact_FullMethodName: auth.PermWrite,
+	cmv1.ContactManager_ShareGroup_FullMethodName:   auth.PermWrite,
+	cmv1.ContactManager_ListShares_FullMethodName:   auth.PermRead,
+	cmv1.ContactManager_RemoveShare_FullMethodName:  auth.PermWrite,
+
+	// address books
+	cmv1.ContactManager_CreateAddressBook_FullMethodName:       auth.PermWrite,
+	cmv1.ContactManager_ListAddressBooks_FullMethodName:        auth.PermRead,
+	cmv1.ContactManager_ListAddressBookMembers_FullMethodName:  auth.PermRead,
+	cmv1.ContactManager_SetAddressBookMember_FullMethodName:    auth.PermWrite,
+	cmv1.ContactManager_RemoveAddressBookMember_FullMethodName: auth.PermWrite,
+}
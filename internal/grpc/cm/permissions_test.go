@@ -0,0 +1,100 @@
+package cm
+
+import (
+	"context"
+	"gRPC_ContactManagement_Service/internal/grpc/authz"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"gRPC_ContactManagement_Service/internal/lib/errinfo"
+	"gRPC_ContactManagement_Service/internal/lib/logger/handlers/slogdiscard"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestMethodPermissionsCoverService(t *testing.T) {
+	var methods []string
+	for _, m := range cmv1.ContactManager_ServiceDesc.Methods {
+		methods = append(methods, "/"+cmv1.ContactManager_ServiceDesc.ServiceName+"/"+m.MethodName)
+	}
+	for _, s := range cmv1.ContactManager_ServiceDesc.Streams {
+		methods = append(methods, "/"+cmv1.ContactManager_ServiceDesc.ServiceName+"/"+s.StreamName)
+	}
+	for _, method := range methods {
+		if perm, ok := MethodPermissions[method]; !ok {
+			t.Errorf("%s has no permission", method)
+		} else if perm != auth.PermRead && perm != auth.PermWrite {
+			t.Errorf("%s requires %d, want read or write", method, perm)
+		}
+	}
+	if len(MethodPermissions) != len(methods) {
+		t.Errorf("MethodPermissions has %d methods, service has %d", len(MethodPermissions), len(methods))
+	}
+}
+
+func TestAuthzInterceptor(t *testing.T) {
+	interceptor := authz.UnaryServerInterceptor(slogdiscard.NewDiscardLogger(), MethodPermissions)
+	call := func(p *auth.Principal, method string) error {
+		ctx := context.Background()
+		if p != nil {
+			ctx = auth.WithPrincipal(ctx, *p)
+		}
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+		return err
+	}
+	adminCheck := func(context.Context) bool {
+		t.Error("admin check called by authz")
+		return true
+	}
+
+	reader := &auth.Principal{UserID: 1, Roles: []auth.Role{auth.RoleReader}, AdminCheck: adminCheck}
+	user := &auth.Principal{UserID: 2, Roles: []auth.Role{auth.RoleUser}, AdminCheck: adminCheck}
+	admin := &auth.Principal{UserID: 3, Roles: []auth.Role{auth.RoleReader, auth.RoleAdmin}}
+	noRoles := &auth.Principal{UserID: 4, AdminCheck: adminCheck}
+
+	for method, perm := range MethodPermissions {
+		if err := call(user, method); err != nil {
+			t.Errorf("user: %s error = %v", method, err)
+		}
+		if err := call(noRoles, method); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("no roles: %s error = %v, want PermissionDenied", method, err)
+		}
+
+		for name, p := range map[string]*auth.Principal{"reader": reader, "admin": admin} {
+			err := call(p, method)
+			if perm == auth.PermRead {
+				if err != nil {
+					t.Errorf("%s: %s error = %v", name, method, err)
+				}
+				continue
+			}
+			assertDenied(t, err)
+		}
+	}
+
+	assertDenied(t, call(user, "/cm.ContactManager/Unknown"))
+	if err := call(nil, cmv1.ContactManager_ListContacts_FullMethodName); status.Code(err) != codes.Internal {
+		t.Errorf("no principal: error = %v, want Internal", err)
+	}
+}
+
+func assertDenied(t *testing.T, err error) {
+	t.Helper()
+	st := status.Convert(err)
+	if st.Code() != codes.PermissionDenied {
+		t.Fatalf("error = %v, want PermissionDenied", err)
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			if info.GetReason() != "PERMISSION_DENIED" || info.GetDomain() != errinfo.Domain {
+				t.Errorf("ErrorInfo = %v", info)
+			}
+			return
+		}
+	}
+	t.Errorf("error has no ErrorInfo: %v", err)
+}
@@ -0,0 +1,86 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/errinfo"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+)
+
+func (s *serverAPI) GetUniquenessPolicy(
+	ctx context.Context,
+	req *cmv1.GetUniquenessPolicyRequest,
+) (*cmv1.GetUniquenessPolicyResponse, error) {
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.cm.GetUniquenessPolicy(ctx, ownerKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "cannot get uniqueness policy")
+	}
+	return &cmv1.GetUniquenessPolicyResponse{Policy: policyToProto(policy)}, nil
+}
+
+func (s *serverAPI) SetUniquenessPolicy(
+	ctx context.Context,
+	req *cmv1.SetUniquenessPolicyRequest,
+) (*cmv1.SetUniquenessPolicyResponse, error) {
+	if req.GetPolicy() == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy required")
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := models.UniquenessPolicy{
+		UniqueEmail: req.GetPolicy().GetUniqueEmail(),
+		UniquePhone: req.GetPolicy().GetUniquePhone(),
+	}
+	err = s.cm.SetUniquenessPolicy(ctx, ownerKey, policy)
+	if err != nil {
+		if errors.Is(err, cm.ErrContactExists) {
+			return nil, contactExistsStatus(codes.FailedPrecondition, err).Err()
+		}
+		return nil, status.Error(codes.Internal, "cannot set uniqueness policy")
+	}
+	return &cmv1.SetUniquenessPolicyResponse{Policy: policyToProto(policy)}, nil
+}
+
+func policyToProto(policy models.UniquenessPolicy) *cmv1.UniquenessPolicy {
+	return &cmv1.UniquenessPolicy{
+		UniqueEmail: policy.UniqueEmail,
+		UniquePhone: policy.UniquePhone,
+	}
+}
+
+// Describes collision with an existing contact. When err tells which field collided,
+// the status carries ErrorInfo with the field and id of the existing contact
+func contactExistsStatus(code codes.Code, err error) *status.Status {
+	var existsErr *models.ContactExistsError
+	if !errors.As(err, &existsErr) {
+		return status.New(code, "contact already exists")
+	}
+
+	return errinfo.Status(code, contactExistsMessage(err), "CONTACT_EXISTS", map[string]string{
+		"field":       existsErr.Field,
+		"existing_id": strconv.FormatInt(existsErr.ExistingID, 10),
+	})
+}
+
+func contactExistsMessage(err error) string {
+	var existsErr *models.ContactExistsError
+	if !errors.As(err, &existsErr) {
+		return "contact already exists"
+	}
+	return fmt.Sprintf("contact with this %s already exists (id %d)", existsErr.Field, existsErr.ExistingID)
+}
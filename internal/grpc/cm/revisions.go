@@ -0,0 +1,108 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var revisionActions = map[models.RevisionAction]cmv1.RevisionAction{
+	models.RevisionCreate:  cmv1.RevisionAction_REVISION_ACTION_CREATE,
+	models.RevisionUpdate:  cmv1.RevisionAction_REVISION_ACTION_UPDATE,
+	models.RevisionDelete:  cmv1.RevisionAction_REVISION_ACTION_DELETE,
+	models.RevisionRestore: cmv1.RevisionAction_REVISION_ACTION_RESTORE,
+	models.RevisionRevert:  cmv1.RevisionAction_REVISION_ACTION_REVERT,
+	models.RevisionPurge:   cmv1.RevisionAction_REVISION_ACTION_PURGE,
+}
+
+func (s *serverAPI) ListContactRevisions(
+	ctx context.Context,
+	req *cmv1.ListContactRevisionsRequest,
+) (*cmv1.ListContactRevisionsResponse, error) {
+	if req.GetContactId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "contact_id required")
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.cm.ListContactRevisions(ctx, ownerKey, req.GetContactId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "cannot list contact revisions")
+	}
+
+	resp := &cmv1.ListContactRevisionsResponse{
+		Revisions: make([]*cmv1.ContactRevision, 0, len(revisions)),
+	}
+	for _, rev := range revisions {
+		resp.Revisions = append(resp.Revisions, &cmv1.ContactRevision{
+			Id:        rev.ID,
+			ContactId: rev.ContactID,
+			Action:    revisionActions[rev.Action],
+			Author:    rev.Author,
+			CreatedAt: rev.CreatedAt.Unix(),
+			Before:    snapshotToProto(rev.Before),
+			After:     snapshotToProto(rev.After),
+		})
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) RestoreContactRevision(
+	ctx context.Context,
+	req *cmv1.RestoreContactRevisionRequest,
+) (*cmv1.RestoreContactRevisionResponse, error) {
+	if req.GetContactId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "contact_id required")
+	}
+	if req.GetRevisionId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "revision_id required")
+	}
+	version, err := parseEtag(req.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := s.cm.RestoreContactRevision(ctx, ownerKey, req.GetContactId(), req.GetRevisionId(), version)
+	if err != nil {
+		if errors.Is(err, cm.ErrRevisionNotFound) {
+			return nil, status.Error(codes.NotFound, "revision not found")
+		}
+		if errors.Is(err, cm.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "contact not found")
+		}
+		if errors.Is(err, cm.ErrVersionMismatch) {
+			return nil, status.Error(codes.Aborted, "contact has been changed, etag mismatch")
+		}
+		if errors.Is(err, cm.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, "contact is shared read-only")
+		}
+		if errors.Is(err, cm.ErrContactExists) {
+			return nil, contactExistsStatus(codes.AlreadyExists, err).Err()
+		}
+		return nil, status.Error(codes.Internal, "cannot restore contact revision")
+	}
+	return &cmv1.RestoreContactRevisionResponse{Contact: contactToProto(contact)}, nil
+}
+
+func snapshotToProto(snapshot *models.ContactSnapshot) *cmv1.ContactSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+	return &cmv1.ContactSnapshot{
+		Name:   snapshot.Name,
+		Emails: emailsToProto(snapshot.Emails),
+		Phones: phonesToProto(snapshot.Phones),
+	}
+}
@@ -4,77 +4,170 @@ import (
 	"context"
 	"errors"
 	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"gRPC_ContactManagement_Service/internal/lib/phonenum"
 	"gRPC_ContactManagement_Service/internal/service/cm"
 	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"regexp"
+	"slices"
+	"strings"
 )
 
-const emailContextKey = "creatorEmail"
+var contactOrders = map[cmv1.ContactOrder]models.ContactOrder{
+	cmv1.ContactOrder_CONTACT_ORDER_UNSPECIFIED: models.OrderByID,
+	cmv1.ContactOrder_CONTACT_ORDER_ID:          models.OrderByID,
+	cmv1.ContactOrder_CONTACT_ORDER_NAME:        models.OrderByName,
+	cmv1.ContactOrder_CONTACT_ORDER_EMAIL:       models.OrderByEmail,
+	cmv1.ContactOrder_CONTACT_ORDER_CREATED_AT:  models.OrderByCreatedAt,
+}
 
 type ContactManager interface {
 	CreateContact(
 		ctx context.Context,
-		creatorEmail, name, email, phone string,
+		ownerKey string,
+		contact models.Contact,
 	) (uid int64, err error)
 
 	GetContactByName(
 		ctx context.Context,
-		creatorEmail string,
+		ownerKey string,
 		name string,
 	) (models.Contact, error)
 
 	GetContactByEmail(
 		ctx context.Context,
-		creatorEmail string,
+		ownerKey string,
 		email string,
 	) (models.Contact, error)
 
 	GetContactByPhone(
 		ctx context.Context,
-		creatorEmail string,
+		ownerKey string,
 		phone string,
 	) (models.Contact, error)
 
 	DeleteContact(
 		ctx context.Context,
-		creatorEmail string,
-		id int64,
+		ownerKey string,
+		id, version int64,
+	) error
+
+	UpdateContact(
+		ctx context.Context,
+		ownerKey string,
+		id, version int64,
+		upd models.ContactUpdate,
+	) (models.Contact, error)
+
+	ListContacts(
+		ctx context.Context,
+		ownerKey string,
+		filter models.ContactFilter,
+		orderBy models.ContactOrder,
+		desc bool,
+		pageSize int,
+		pageToken string,
+	) ([]models.Contact, string, error)
+
+	SearchContacts(
+		ctx context.Context,
+		ownerKey, query string,
+		filter models.ContactFilter,
+		limit int,
+	) ([]models.Contact, error)
+
+	FuzzySearchContacts(
+		ctx context.Context,
+		ownerKey, name string,
+		limit int,
+	) ([]models.ContactMatch, error)
+
+	ExportContacts(
+		ctx context.Context,
+		ownerKey string,
+		filter models.ContactFilter,
+		yield func(models.Contact) error,
 	) error
+
+	ListDeletedContacts(
+		ctx context.Context,
+		ownerKey string,
+		pageSize int,
+		pageToken string,
+	) ([]models.Contact, string, error)
+	RestoreContact(ctx context.Context, ownerKey string, id, version int64) error
+	PurgeContact(ctx context.Context, ownerKey string, id, version int64) error
+
+	ListContactRevisions(ctx context.Context, ownerKey string, contactID int64) ([]models.Revision, error)
+	RestoreContactRevision(ctx context.Context, ownerKey string, contactID, revisionID, version int64) (models.Contact, error)
+
+	FindDuplicates(ctx context.Context, ownerKey string) ([]models.DuplicateCluster, error)
+	MergeContacts(ctx context.Context, ownerKey string, survivorID, version int64, mergedIDs []int64) (models.Contact, int64, error)
+	UndoMerge(ctx context.Context, ownerKey string, mergeID int64) (models.Contact, []int64, error)
+
+	GetUniquenessPolicy(ctx context.Context, ownerKey string) (models.UniquenessPolicy, error)
+	SetUniquenessPolicy(ctx context.Context, ownerKey string, policy models.UniquenessPolicy) error
+
+	BatchCreateContacts(ctx context.Context, ownerKey string, contacts []models.Contact, atomic bool) ([]models.BatchResult, error)
+	BatchDeleteContacts(ctx context.Context, ownerKey string, refs []models.ContactRef, atomic bool) ([]models.BatchResult, error)
+
+	WatchContacts(ctx context.Context, ownerKey string, afterID int64, yield func(models.ContactEvent) error) error
+	SyncContacts(ctx context.Context, ownerKey, token string, pageSize int) ([]models.ContactChange, string, bool, error)
+
+	ShareContact(ctx context.Context, ownerKey string, contactID int64, granteeEmail string, access models.Access) (models.Share, error)
+	ShareGroup(ctx context.Context, ownerKey string, groupID int64, granteeEmail string, access models.Access) (models.Share, error)
+	RemoveShare(ctx context.Context, ownerKey string, id int64) error
+	ListShares(ctx context.Context, ownerKey string) ([]models.Share, error)
+
+	RegisterUser(ctx context.Context, user auth.Principal) (string, error)
+	AddressBookKey(ctx context.Context, ownerKey string, bookID int64, need models.BookRole) (string, error)
+	AnyAddressBookKey(ctx context.Context, bookID int64) (string, error)
+	CreateAddressBook(ctx context.Context, email, name string) (int64, error)
+	ListAddressBooks(ctx context.Context, ownerKey string) ([]models.AddressBook, error)
+	ListAddressBookMembers(ctx context.Context, ownerKey, email string, bookID int64) ([]models.AddressBookMember, error)
+	SetAddressBookMember(ctx context.Context, ownerKey string, bookID int64, memberEmail string, role models.BookRole) error
+	RemoveAddressBookMember(ctx context.Context, ownerKey, email string, bookID int64, memberEmail string) error
+
+	CreateGroup(ctx context.Context, ownerKey, name string) (int64, error)
+	RenameGroup(ctx context.Context, ownerKey string, id int64, name string) error
+	DeleteGroup(ctx context.Context, ownerKey string, id int64) error
+	ListGroups(ctx context.Context, ownerKey string) ([]models.Group, error)
+	AddGroupMembers(ctx context.Context, ownerKey string, groupID int64, contactIDs []int64) error
+	RemoveGroupMembers(ctx context.Context, ownerKey string, groupID int64, contactIDs []int64) error
+	AddContactTags(ctx context.Context, ownerKey string, contactID, version int64, tags []string) error
+	RemoveContactTags(ctx context.Context, ownerKey string, contactID, version int64, tags []string) error
 }
 
 type serverAPI struct {
 	cmv1.UnimplementedContactManagerServer
 	cm ContactManager
+	// region of phone numbers written without country code
+	phoneRegion string
 }
 
-func Register(gRPC *grpc.Server, cm ContactManager) {
-	cmv1.RegisterContactManagerServer(gRPC, &serverAPI{cm: cm})
+func Register(gRPC *grpc.Server, cm ContactManager, phoneRegion string) {
+	cmv1.RegisterContactManagerServer(gRPC, &serverAPI{cm: cm, phoneRegion: phoneRegion})
 }
 
 func (s *serverAPI) CreateContact(
 	ctx context.Context,
 	req *cmv1.CreateContactRequest,
 ) (*cmv1.CreateContactResponse, error) {
-	if err := validateCreateContactRequest(req); err != nil {
-		return nil, err
-	}
-	if err := validateEmail(req.GetEmail()); err != nil {
-		return nil, err
-	}
-	if err := validatePhone(req.GetPhone()); err != nil {
+	contact, err := validateCreateContactRequest(req, s.phoneRegion)
+	if err != nil {
 		return nil, err
 	}
-	creatorEmail, err := getEmailFromContext(ctx)
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
 	if err != nil {
 		return nil, err
 	}
-	uid, err := s.cm.CreateContact(ctx, creatorEmail, req.GetName(), req.GetEmail(), req.GetPhone())
+	uid, err := s.cm.CreateContact(ctx, ownerKey, contact)
 	if err != nil {
 		if errors.Is(err, cm.ErrContactExists) {
-			return nil, status.Error(codes.AlreadyExists, "contact already exists")
+			return nil, contactExistsStatus(codes.AlreadyExists, err).Err()
 		}
 		return nil, status.Error(codes.Internal, "cannot add new contact")
 	}
@@ -88,24 +181,19 @@ func (s *serverAPI) GetContactByName(
 	if req.GetName() == "" {
 		return nil, status.Error(codes.InvalidArgument, "name required")
 	}
-	creatorEmail, err := getEmailFromContext(ctx)
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
 	if err != nil {
 		return nil, err
 	}
 
-	contact, err := s.cm.GetContactByName(ctx, creatorEmail, req.GetName())
+	contact, err := s.cm.GetContactByName(ctx, ownerKey, req.GetName())
 	if err != nil {
 		if errors.Is(err, cm.ErrContactNotFound) {
 			return nil, status.Error(codes.NotFound, "contact not found")
 		}
 		return nil, status.Error(codes.Internal, "cannot find contact")
 	}
-	return &cmv1.GetContactResponse{
-		Id:    contact.ID,
-		Name:  contact.Name,
-		Email: contact.Email,
-		Phone: contact.Phone,
-	}, nil
+	return contactToGetResponse(contact), nil
 }
 
 func (s *serverAPI) GetContactByEmail(
@@ -116,24 +204,19 @@ func (s *serverAPI) GetContactByEmail(
 		return nil, status.Error(codes.InvalidArgument, "email required")
 	}
 
-	creatorEmail, err := getEmailFromContext(ctx)
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
 	if err != nil {
 		return nil, err
 	}
 
-	contact, err := s.cm.GetContactByEmail(ctx, creatorEmail, req.GetEmail())
+	contact, err := s.cm.GetContactByEmail(ctx, ownerKey, req.GetEmail())
 	if err != nil {
 		if errors.Is(err, cm.ErrContactNotFound) {
 			return nil, status.Error(codes.NotFound, "contact not found")
 		}
 		return nil, status.Error(codes.Internal, "cannot find contact")
 	}
-	return &cmv1.GetContactResponse{
-		Id:    contact.ID,
-		Name:  contact.Name,
-		Email: contact.Email,
-		Phone: contact.Phone,
-	}, nil
+	return contactToGetResponse(contact), nil
 }
 
 func (s *serverAPI) GetContactByPhone(
@@ -143,25 +226,24 @@ func (s *serverAPI) GetContactByPhone(
 	if req.GetPhone() == "" {
 		return nil, status.Error(codes.InvalidArgument, "phone required")
 	}
+	phone, err := validatePhone(req.GetPhone(), s.phoneRegion)
+	if err != nil {
+		return nil, err
+	}
 
-	creatorEmail, err := getEmailFromContext(ctx)
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
 	if err != nil {
 		return nil, err
 	}
 
-	contact, err := s.cm.GetContactByPhone(ctx, creatorEmail, req.GetPhone())
+	contact, err := s.cm.GetContactByPhone(ctx, ownerKey, phone)
 	if err != nil {
 		if errors.Is(err, cm.ErrContactNotFound) {
 			return nil, status.Error(codes.NotFound, "contact not found")
 		}
 		return nil, status.Error(codes.Internal, "cannot find contact")
 	}
-	return &cmv1.GetContactResponse{
-		Id:    contact.ID,
-		Name:  contact.Name,
-		Email: contact.Email,
-		Phone: contact.Phone,
-	}, nil
+	return contactToGetResponse(contact), nil
 }
 
 func (s *serverAPI) DeleteContact(
@@ -171,17 +253,27 @@ func (s *serverAPI) DeleteContact(
 	if req.GetId() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "id required")
 	}
+	version, err := parseEtag(req.GetEtag())
+	if err != nil {
+		return nil, err
+	}
 
-	creatorEmail, err := getEmailFromContext(ctx)
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.cm.DeleteContact(ctx, creatorEmail, req.GetId())
+	err = s.cm.DeleteContact(ctx, ownerKey, req.GetId(), version)
 
 	if err != nil {
 		if errors.Is(err, cm.ErrContactNotFound) {
-			return nil, status.Error(codes.InvalidArgument, "contact not found")
+			return nil, status.Error(codes.NotFound, "contact not found")
+		}
+		if errors.Is(err, cm.ErrVersionMismatch) {
+			return nil, status.Error(codes.Aborted, "contact has been changed, etag mismatch")
+		}
+		if errors.Is(err, cm.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, "contact is shared read-only")
 		}
 		return nil, status.Error(codes.Internal, "cannot find contact")
 	}
@@ -189,17 +281,262 @@ func (s *serverAPI) DeleteContact(
 	return &cmv1.DeleteContactResponse{Success: true}, nil
 }
 
-func validateCreateContactRequest(req *cmv1.CreateContactRequest) error {
+func (s *serverAPI) UpdateContact(
+	ctx context.Context,
+	req *cmv1.UpdateContactRequest,
+) (*cmv1.UpdateContactResponse, error) {
+	upd, err := validateUpdateContactRequest(req, s.phoneRegion)
+	if err != nil {
+		return nil, err
+	}
+	version, err := parseEtag(req.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := s.cm.UpdateContact(ctx, ownerKey, req.GetId(), version, upd)
+	if err != nil {
+		if errors.Is(err, cm.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "contact not found")
+		}
+		if errors.Is(err, cm.ErrVersionMismatch) {
+			return nil, status.Error(codes.Aborted, "contact has been changed, etag mismatch")
+		}
+		if errors.Is(err, cm.ErrContactExists) {
+			return nil, contactExistsStatus(codes.AlreadyExists, err).Err()
+		}
+		if errors.Is(err, cm.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, "contact is shared read-only")
+		}
+		return nil, status.Error(codes.Internal, "cannot update contact")
+	}
+
+	return &cmv1.UpdateContactResponse{
+		Id:     contact.ID,
+		Name:   contact.Name,
+		Email:  contact.Email,
+		Phone:  contact.Phone,
+		Emails: emailsToProto(contact.Emails),
+		Phones: phonesToProto(contact.Phones),
+		Tags:   contact.Tags,
+		Etag:   versionToEtag(contact.Version),
+	}, nil
+}
+
+func (s *serverAPI) ListContacts(
+	ctx context.Context,
+	req *cmv1.ListContactsRequest,
+) (*cmv1.ListContactsResponse, error) {
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+	filter, err := validateContactFilter(req.GetGroupId(), req.GetTag())
+	if err != nil {
+		return nil, err
+	}
+	orderBy, ok := contactOrders[req.GetOrderBy()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown order_by")
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	contacts, nextPageToken, err := s.cm.ListContacts(
+		ctx,
+		ownerKey,
+		filter,
+		orderBy,
+		req.GetDescending(),
+		int(req.GetPageSize()),
+		req.GetPageToken(),
+	)
+	if err != nil {
+		if errors.Is(err, cm.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Error(codes.Internal, "cannot list contacts")
+	}
+
+	resp := &cmv1.ListContactsResponse{
+		Contacts:      make([]*cmv1.Contact, 0, len(contacts)),
+		NextPageToken: nextPageToken,
+	}
+	for _, contact := range contacts {
+		resp.Contacts = append(resp.Contacts, contactToProto(contact))
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) SearchContacts(
+	ctx context.Context,
+	req *cmv1.SearchContactsRequest,
+) (*cmv1.SearchContactsResponse, error) {
+	if strings.TrimSpace(req.GetQuery()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "query required")
+	}
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+	filter, err := validateContactFilter(req.GetGroupId(), req.GetTag())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	contacts, err := s.cm.SearchContacts(ctx, ownerKey, req.GetQuery(), filter, int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "cannot search contacts")
+	}
+
+	resp := &cmv1.SearchContactsResponse{
+		Contacts: make([]*cmv1.Contact, 0, len(contacts)),
+	}
+	for _, contact := range contacts {
+		resp.Contacts = append(resp.Contacts, contactToProto(contact))
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) FuzzySearchContacts(
+	ctx context.Context,
+	req *cmv1.FuzzySearchContactsRequest,
+) (*cmv1.FuzzySearchContactsResponse, error) {
+	if strings.TrimSpace(req.GetName()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name required")
+	}
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.cm.FuzzySearchContacts(ctx, ownerKey, req.GetName(), int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "cannot search contacts")
+	}
+
+	resp := &cmv1.FuzzySearchContactsResponse{
+		Matches: make([]*cmv1.ContactMatch, 0, len(matches)),
+	}
+	for _, match := range matches {
+		resp.Matches = append(resp.Matches, &cmv1.ContactMatch{
+			Contact: contactToProto(match.Contact),
+			Score:   match.Score,
+		})
+	}
+	return resp, nil
+}
+
+// Validates create request and builds a contact from it.
+// Top-level email and phone are merged into the labeled lists as primary values,
+// phones are normalized to E.164
+func validateCreateContactRequest(req *cmv1.CreateContactRequest, phoneRegion string) (models.Contact, error) {
 	if req.GetName() == "" {
-		return status.Error(codes.InvalidArgument, "name required")
+		return models.Contact{}, status.Error(codes.InvalidArgument, "name required")
 	}
-	if req.GetEmail() == "" {
-		return status.Error(codes.InvalidArgument, "email required")
+	if req.GetEmail() == "" && len(req.GetEmails()) == 0 {
+		return models.Contact{}, status.Error(codes.InvalidArgument, "email required")
 	}
-	if req.GetPhone() == "" {
-		return status.Error(codes.InvalidArgument, "phone required")
+	if req.GetPhone() == "" && len(req.GetPhones()) == 0 {
+		return models.Contact{}, status.Error(codes.InvalidArgument, "phone required")
 	}
-	return nil
+
+	emails, err := emailsFromProto(req.GetEmail(), req.GetEmails())
+	if err != nil {
+		return models.Contact{}, err
+	}
+	phones, err := phonesFromProto(req.GetPhone(), req.GetPhones(), phoneRegion)
+	if err != nil {
+		return models.Contact{}, err
+	}
+
+	return models.Contact{
+		Name:   req.GetName(),
+		Email:  primaryEmail(emails),
+		Phone:  primaryPhone(phones),
+		Emails: emails,
+		Phones: phones,
+	}, nil
+}
+
+// Builds contact update from the fields listed in update mask.
+// Only the changed fields are validated
+func validateUpdateContactRequest(req *cmv1.UpdateContactRequest, phoneRegion string) (models.ContactUpdate, error) {
+	var upd models.ContactUpdate
+	if req.GetId() <= 0 {
+		return upd, status.Error(codes.InvalidArgument, "id required")
+	}
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		return upd, status.Error(codes.InvalidArgument, "update_mask required")
+	}
+	if slices.Contains(paths, "email") && slices.Contains(paths, "emails") {
+		return upd, status.Error(codes.InvalidArgument, "email and emails cannot be updated together")
+	}
+	if slices.Contains(paths, "phone") && slices.Contains(paths, "phones") {
+		return upd, status.Error(codes.InvalidArgument, "phone and phones cannot be updated together")
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "name":
+			if req.GetName() == "" {
+				return upd, status.Error(codes.InvalidArgument, "name required")
+			}
+			name := req.GetName()
+			upd.Name = &name
+		case "email":
+			if err := validateEmail(req.GetEmail()); err != nil {
+				return upd, err
+			}
+			email := req.GetEmail()
+			upd.Email = &email
+		case "phone":
+			phone, err := validatePhone(req.GetPhone(), phoneRegion)
+			if err != nil {
+				return upd, err
+			}
+			upd.Phone = &phone
+		case "emails":
+			if len(req.GetEmails()) == 0 {
+				return upd, status.Error(codes.InvalidArgument, "email required")
+			}
+			emails, err := emailsFromProto("", req.GetEmails())
+			if err != nil {
+				return upd, err
+			}
+			email := primaryEmail(emails)
+			upd.Emails, upd.Email = emails, &email
+		case "phones":
+			if len(req.GetPhones()) == 0 {
+				return upd, status.Error(codes.InvalidArgument, "phone required")
+			}
+			phones, err := phonesFromProto("", req.GetPhones(), phoneRegion)
+			if err != nil {
+				return upd, err
+			}
+			phone := primaryPhone(phones)
+			upd.Phones, upd.Phone = phones, &phone
+		default:
+			return upd, status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+		}
+	}
+	return upd, nil
 }
 
 func validateEmail(email string) error {
@@ -210,21 +547,61 @@ func validateEmail(email string) error {
 	return nil
 }
 
-func validatePhone(phone string) error {
-	re := regexp.MustCompile(`^\+7\d{10}$`)
-	if !re.MatchString(phone) {
-		return status.Error(codes.InvalidArgument, "invalid phone format")
+// Validates phone and returns it in E.164.
+// Numbers without country code are treated as numbers of phoneRegion
+func validatePhone(phone, phoneRegion string) (string, error) {
+	normalized, err := phonenum.Normalize(phone, phoneRegion)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, "invalid phone format")
 	}
-	return nil
+	return normalized, nil
 }
 
-// Extracts email from context
-func getEmailFromContext(ctx context.Context) (string, error) {
-	creatorEmail, ok := ctx.Value(emailContextKey).(string)
+func contactToProto(contact models.Contact) *cmv1.Contact {
+	res := &cmv1.Contact{
+		Id:            contact.ID,
+		Name:          contact.Name,
+		Email:         contact.Email,
+		Phone:         contact.Phone,
+		CreatedAt:     contact.CreatedAt.Unix(),
+		Emails:        emailsToProto(contact.Emails),
+		Phones:        phonesToProto(contact.Phones),
+		Tags:          contact.Tags,
+		Etag:          versionToEtag(contact.Version),
+		OwnerEmail:    contact.OwnerEmail,
+		AddressBookId: contact.AddressBookID,
+	}
+	if !contact.DeletedAt.IsZero() {
+		res.DeletedAt = contact.DeletedAt.Unix()
+	}
+	return res
+}
 
+func contactToGetResponse(contact models.Contact) *cmv1.GetContactResponse {
+	return &cmv1.GetContactResponse{
+		Id:            contact.ID,
+		Name:          contact.Name,
+		Email:         contact.Email,
+		Phone:         contact.Phone,
+		Emails:        emailsToProto(contact.Emails),
+		Phones:        phonesToProto(contact.Phones),
+		Tags:          contact.Tags,
+		Etag:          versionToEtag(contact.Version),
+		OwnerEmail:    contact.OwnerEmail,
+		AddressBookId: contact.AddressBookID,
+	}
+}
+
+// Returns the authenticated user from context and the key the user's personal data is stored under
+func (s *serverAPI) user(ctx context.Context) (auth.Principal, string, error) {
+	user, ok := auth.FromContext(ctx)
 	if !ok {
-		return "", status.Error(codes.Internal, "cannot get user email")
+		return auth.Principal{}, "", status.Error(codes.Internal, "cannot get user")
 	}
 
-	return creatorEmail, nil
+	key, err := s.cm.RegisterUser(ctx, user)
+	if err != nil {
+		return auth.Principal{}, "", status.Error(codes.Internal, "cannot register user")
+	}
+	return user, key, nil
 }
@@ -0,0 +1,158 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var shareAccesses = map[cmv1.ShareAccess]models.Access{
+	cmv1.ShareAccess_SHARE_ACCESS_READ:  models.AccessRead,
+	cmv1.ShareAccess_SHARE_ACCESS_WRITE: models.AccessWrite,
+}
+
+var shareAccessesToProto = map[models.Access]cmv1.ShareAccess{
+	models.AccessRead:  cmv1.ShareAccess_SHARE_ACCESS_READ,
+	models.AccessWrite: cmv1.ShareAccess_SHARE_ACCESS_WRITE,
+}
+
+func (s *serverAPI) ShareContact(
+	ctx context.Context,
+	req *cmv1.ShareContactRequest,
+) (*cmv1.ShareContactResponse, error) {
+	if req.GetContactId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "contact_id required")
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	user, _, err := s.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+	access, err := validateShare(user.Email, req.GetGranteeEmail(), req.GetAccess())
+	if err != nil {
+		return nil, err
+	}
+
+	share, err := s.cm.ShareContact(ctx, ownerKey, req.GetContactId(), req.GetGranteeEmail(), access)
+	if err != nil {
+		if errors.Is(err, cm.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "contact not found")
+		}
+		return nil, status.Error(codes.Internal, "cannot share contact")
+	}
+	return &cmv1.ShareContactResponse{Share: shareToProto(share)}, nil
+}
+
+func (s *serverAPI) ShareGroup(
+	ctx context.Context,
+	req *cmv1.ShareGroupRequest,
+) (*cmv1.ShareGroupResponse, error) {
+	if req.GetGroupId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "group_id required")
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	user, _, err := s.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+	access, err := validateShare(user.Email, req.GetGranteeEmail(), req.GetAccess())
+	if err != nil {
+		return nil, err
+	}
+
+	share, err := s.cm.ShareGroup(ctx, ownerKey, req.GetGroupId(), req.GetGranteeEmail(), access)
+	if err != nil {
+		if errors.Is(err, cm.ErrGroupNotFound) {
+			return nil, status.Error(codes.NotFound, "group not found")
+		}
+		return nil, status.Error(codes.Internal, "cannot share group")
+	}
+	return &cmv1.ShareGroupResponse{Share: shareToProto(share)}, nil
+}
+
+func (s *serverAPI) RemoveShare(
+	ctx context.Context,
+	req *cmv1.RemoveShareRequest,
+) (*cmv1.RemoveShareResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id required")
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cm.RemoveShare(ctx, ownerKey, req.GetId())
+	if err != nil {
+		if errors.Is(err, cm.ErrShareNotFound) {
+			return nil, status.Error(codes.NotFound, "share not found")
+		}
+		return nil, status.Error(codes.Internal, "cannot remove share")
+	}
+	return &cmv1.RemoveShareResponse{Success: true}, nil
+}
+
+func (s *serverAPI) ListShares(
+	ctx context.Context,
+	req *cmv1.ListSharesRequest,
+) (*cmv1.ListSharesResponse, error) {
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.cm.ListShares(ctx, ownerKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "cannot list shares")
+	}
+
+	resp := &cmv1.ListSharesResponse{
+		Shares: make([]*cmv1.Share, 0, len(shares)),
+	}
+	for _, share := range shares {
+		resp.Shares = append(resp.Shares, shareToProto(share))
+	}
+	return resp, nil
+}
+
+// Validates grantee and access of a new share. Users can't share contacts with themselves
+func validateShare(email, granteeEmail string, access cmv1.ShareAccess) (models.Access, error) {
+	if granteeEmail == "" {
+		return models.AccessNone, status.Error(codes.InvalidArgument, "grantee_email required")
+	}
+	if err := validateEmail(granteeEmail); err != nil {
+		return models.AccessNone, err
+	}
+	if granteeEmail == email {
+		return models.AccessNone, status.Error(codes.InvalidArgument, "cannot share with yourself")
+	}
+	res, ok := shareAccesses[access]
+	if !ok {
+		return models.AccessNone, status.Error(codes.InvalidArgument, "access required")
+	}
+	return res, nil
+}
+
+func shareToProto(share models.Share) *cmv1.Share {
+	return &cmv1.Share{
+		Id:           share.ID,
+		ContactId:    share.ContactID,
+		GroupId:      share.GroupID,
+		GranteeEmail: share.GranteeEmail,
+		Access:       shareAccessesToProto[share.Access],
+		CreatedAt:    share.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,51 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/errinfo"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) SyncContacts(
+	ctx context.Context,
+	req *cmv1.SyncContactsRequest,
+) (*cmv1.SyncContactsResponse, error) {
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, nextToken, more, err := s.cm.SyncContacts(ctx, ownerKey, req.GetSyncToken(), int(req.GetPageSize()))
+	if err != nil {
+		if errors.Is(err, cm.ErrInvalidSyncToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid sync token")
+		}
+		if errors.Is(err, cm.ErrSyncExpired) {
+			return nil, syncExpiredStatus().Err()
+		}
+		return nil, status.Error(codes.Internal, "cannot sync contacts")
+	}
+
+	resp := &cmv1.SyncContactsResponse{NextSyncToken: nextToken, More: more}
+	for _, change := range changes {
+		if change.Deleted {
+			resp.DeletedIds = append(resp.DeletedIds, change.Contact.ID)
+		} else {
+			resp.Contacts = append(resp.Contacts, contactToProto(change.Contact))
+		}
+	}
+	return resp, nil
+}
+
+func syncExpiredStatus() *status.Status {
+	return errinfo.Status(codes.FailedPrecondition, "sync token expired, full resync required", "FULL_RESYNC_REQUIRED", nil)
+}
@@ -0,0 +1,80 @@
+//go:build sqlite_fts5
+
+package cm
+
+import (
+	"context"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"gRPC_ContactManagement_Service/internal/lib/errinfo"
+	"gRPC_ContactManagement_Service/internal/lib/eventbus"
+	"gRPC_ContactManagement_Service/internal/lib/logger/handlers/slogdiscard"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	"gRPC_ContactManagement_Service/internal/storage/sqlite/sqlitetest"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+// Returns server on storage with all migrations and context of a request by its user
+func newTestServer(t *testing.T) (*serverAPI, *cm.ContactManager, context.Context) {
+	t.Helper()
+	storage, _ := sqlitetest.New(t)
+	contacts := cm.New(slogdiscard.NewDiscardLogger(), storage, eventbus.New[models.ContactEvent](1), 0.5)
+	s := &serverAPI{cm: contacts, phoneRegion: "RU"}
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{UserID: 1, Email: "owner@example.com", AppID: 1})
+	return s, contacts, ctx
+}
+
+func TestSyncAfterPrunedTombstonesRequiresFullResync(t *testing.T) {
+	s, contacts, ctx := newTestServer(t)
+
+	created, err := s.CreateContact(ctx, &cmv1.CreateContactRequest{Name: "Ivan", Email: "ivan@example.com", Phone: "+79123456789"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err := s.SyncContacts(ctx, &cmv1.SyncContactsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.DeleteContact(ctx, &cmv1.DeleteContactRequest{Id: created.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.PurgeContact(ctx, &cmv1.PurgeContactRequest{Id: created.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.SyncContacts(ctx, &cmv1.SyncContactsRequest{SyncToken: first.GetNextSyncToken()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetDeletedIds()) != 1 || resp.GetDeletedIds()[0] != created.GetId() {
+		t.Fatalf("deleted_ids = %v, want [%d]", resp.GetDeletedIds(), created.GetId())
+	}
+
+	// tombstones purged before now are forgotten
+	if _, err = contacts.PurgeExpiredTombstones(ctx, -time.Second); err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.SyncContacts(ctx, &cmv1.SyncContactsRequest{SyncToken: first.GetNextSyncToken()})
+	st := status.Convert(err)
+	if st.Code() != codes.FailedPrecondition {
+		t.Fatalf("SyncContacts() error = %v, want %v", err, codes.FailedPrecondition)
+	}
+	var info *errdetails.ErrorInfo
+	for _, d := range st.Details() {
+		if i, ok := d.(*errdetails.ErrorInfo); ok {
+			info = i
+		}
+	}
+	if info == nil || info.GetReason() != "FULL_RESYNC_REQUIRED" || info.GetDomain() != errinfo.Domain {
+		t.Errorf("error info = %v, want reason FULL_RESYNC_REQUIRED", info)
+	}
+
+	// the token of the last sync is still good
+	if _, err = s.SyncContacts(ctx, &cmv1.SyncContactsRequest{SyncToken: resp.GetNextSyncToken()}); err != nil {
+		t.Errorf("SyncContacts() with fresh token error = %v", err)
+	}
+}
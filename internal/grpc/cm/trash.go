@@ -0,0 +1,116 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) ListDeletedContacts(
+	ctx context.Context,
+	req *cmv1.ListDeletedContactsRequest,
+) (*cmv1.ListDeletedContactsResponse, error) {
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	contacts, nextPageToken, err := s.cm.ListDeletedContacts(
+		ctx,
+		ownerKey,
+		int(req.GetPageSize()),
+		req.GetPageToken(),
+	)
+	if err != nil {
+		if errors.Is(err, cm.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Error(codes.Internal, "cannot list deleted contacts")
+	}
+
+	resp := &cmv1.ListDeletedContactsResponse{
+		Contacts:      make([]*cmv1.Contact, 0, len(contacts)),
+		NextPageToken: nextPageToken,
+	}
+	for _, contact := range contacts {
+		resp.Contacts = append(resp.Contacts, contactToProto(contact))
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) RestoreContact(
+	ctx context.Context,
+	req *cmv1.RestoreContactRequest,
+) (*cmv1.RestoreContactResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id required")
+	}
+	version, err := parseEtag(req.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cm.RestoreContact(ctx, ownerKey, req.GetId(), version)
+	if err != nil {
+		if errors.Is(err, cm.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "contact not found in trash")
+		}
+		if errors.Is(err, cm.ErrVersionMismatch) {
+			return nil, status.Error(codes.Aborted, "contact has been changed, etag mismatch")
+		}
+		if errors.Is(err, cm.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, "contact is shared read-only")
+		}
+		if errors.Is(err, cm.ErrContactExists) {
+			return nil, contactExistsStatus(codes.AlreadyExists, err).Err()
+		}
+		return nil, status.Error(codes.Internal, "cannot restore contact")
+	}
+	return &cmv1.RestoreContactResponse{Success: true}, nil
+}
+
+func (s *serverAPI) PurgeContact(
+	ctx context.Context,
+	req *cmv1.PurgeContactRequest,
+) (*cmv1.PurgeContactResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id required")
+	}
+	version, err := parseEtag(req.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cm.PurgeContact(ctx, ownerKey, req.GetId(), version)
+	if err != nil {
+		if errors.Is(err, cm.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "contact not found in trash")
+		}
+		if errors.Is(err, cm.ErrVersionMismatch) {
+			return nil, status.Error(codes.Aborted, "contact has been changed, etag mismatch")
+		}
+		if errors.Is(err, cm.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, "contact is shared read-only")
+		}
+		return nil, status.Error(codes.Internal, "cannot purge contact")
+	}
+	return &cmv1.PurgeContactResponse{Success: true}, nil
+}
@@ -0,0 +1,304 @@
+package cm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/vcard"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"strings"
+)
+
+const maxImportResults = 1000
+
+var vcardVersions = map[cmv1.VCardVersion]string{
+	cmv1.VCardVersion_VCARD_VERSION_UNSPECIFIED: vcard.Version40,
+	cmv1.VCardVersion_VCARD_VERSION_3_0:         vcard.Version30,
+	cmv1.VCardVersion_VCARD_VERSION_4_0:         vcard.Version40,
+}
+
+func (s *serverAPI) ExportContacts(
+	req *cmv1.ExportContactsRequest,
+	stream cmv1.ContactManager_ExportContactsServer,
+) error {
+	version, ok := vcardVersions[req.GetVersion()]
+	if !ok {
+		return status.Error(codes.InvalidArgument, "unknown vCard version")
+	}
+	filter, err := validateContactFilter(req.GetGroupId(), req.GetTag())
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
+	if err != nil {
+		return err
+	}
+
+	err = s.cm.ExportContacts(ctx, ownerKey, filter, func(contact models.Contact) error {
+		var buf bytes.Buffer
+		if err := vcard.Encode(&buf, contactToCard(contact, version)); err != nil {
+			return err
+		}
+		return stream.Send(&cmv1.ExportContactsResponse{Vcard: buf.Bytes()})
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Error(codes.Internal, "cannot export contacts")
+	}
+	return nil
+}
+
+func (s *serverAPI) ImportContacts(stream cmv1.ContactManager_ImportContactsServer) error {
+	resp := &cmv1.ImportContactsResponse{}
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return stream.SendAndClose(resp)
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	ownerKey, err := s.addressBook(ctx, first.GetAddressBookId(), models.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	r := vcard.NewReader(&chunkReader{buf: first.GetChunk(), recv: func() ([]byte, error) {
+		req, err := stream.Recv()
+		return req.GetChunk(), err
+	}})
+	for index := int32(0); ; index++ {
+		card, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		result := &cmv1.ImportResult{Index: index}
+		var syntaxErr *vcard.SyntaxError
+		switch {
+		case errors.As(err, &syntaxErr):
+			result.Error = syntaxErr.Error()
+		case err != nil:
+			// Errors of the stream itself are already gRPC statuses
+			return err
+		default:
+			result.Id, result.Name, result.Error = s.importCard(ctx, ownerKey, card)
+		}
+
+		addImportResult(resp, result)
+	}
+	return stream.SendAndClose(resp)
+}
+
+// Adds result of a card or row to import response. Only the first maxImportResults
+// results are reported, so a huge import doesn't make a huge response, the counters cover all of them
+func addImportResult(resp *cmv1.ImportContactsResponse, result *cmv1.ImportResult) {
+	if result.GetError() != "" {
+		resp.Failed++
+	} else {
+		resp.Imported++
+	}
+	if len(resp.Results) < maxImportResults {
+		resp.Results = append(resp.Results, result)
+	}
+}
+
+// Validates card with the same rules as CreateContact and saves it.
+// Returns the reason the card was not imported instead of error
+func (s *serverAPI) importCard(
+	ctx context.Context,
+	ownerKey string,
+	card vcard.Card,
+) (id int64, name string, reason string) {
+	req := cardToCreateRequest(card)
+	contact, err := validateCreateContactRequest(req, s.phoneRegion)
+	if err != nil {
+		return 0, req.GetName(), status.Convert(err).Message()
+	}
+
+	id, err = s.cm.CreateContact(ctx, ownerKey, contact)
+	if err != nil {
+		if errors.Is(err, cm.ErrContactExists) {
+			return 0, contact.Name, contactExistsMessage(err)
+		}
+		return 0, contact.Name, "cannot add new contact"
+	}
+	return id, contact.Name, ""
+}
+
+// Reads data from the chunks of import stream
+type chunkReader struct {
+	recv func() ([]byte, error)
+	buf  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// Maps FN (or N when FN is empty), EMAIL and TEL of the card onto CreateContactRequest.
+// The most preferred email and phone become primary
+func cardToCreateRequest(card vcard.Card) *cmv1.CreateContactRequest {
+	req := &cmv1.CreateContactRequest{Name: cardName(card)}
+
+	seenEmails := make(map[string]bool)
+	primary, bestPref := -1, 101
+	for _, p := range card.All("EMAIL") {
+		email := strings.TrimSpace(p.Text())
+		if email == "" || seenEmails[email] {
+			continue
+		}
+		seenEmails[email] = true
+		if pref := p.Preference(); pref < bestPref {
+			primary, bestPref = len(req.Emails), pref
+		}
+		req.Emails = append(req.Emails, &cmv1.LabeledEmail{Email: email, Label: cardLabel(p)})
+	}
+	if primary >= 0 {
+		req.Emails[primary].Primary = true
+	}
+
+	seenPhones := make(map[string]bool)
+	primary, bestPref = -1, 101
+	for _, p := range card.All("TEL") {
+		phone := cardPhone(p)
+		if phone == "" || seenPhones[phone] {
+			continue
+		}
+		seenPhones[phone] = true
+		if pref := p.Preference(); pref < bestPref {
+			primary, bestPref = len(req.Phones), pref
+		}
+		req.Phones = append(req.Phones, &cmv1.LabeledPhone{Phone: phone, Label: cardLabel(p)})
+	}
+	if primary >= 0 {
+		req.Phones[primary].Primary = true
+	}
+	return req
+}
+
+func cardName(card vcard.Card) string {
+	if fn, ok := card.Get("FN"); ok {
+		if name := strings.TrimSpace(fn.Text()); name != "" {
+			return name
+		}
+	}
+
+	n, ok := card.Get("N")
+	if !ok {
+		return ""
+	}
+	// N is family;given;additional;prefixes;suffixes
+	c := n.Components()
+	var parts []string
+	for _, i := range []int{1, 2, 0} {
+		if i < len(c) && strings.TrimSpace(c[i]) != "" {
+			parts = append(parts, strings.TrimSpace(c[i]))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// vCard 4.0 phones are usually tel: URIs, e.g. "tel:+1-555-555-5555;ext=5555"
+func cardPhone(p vcard.Property) string {
+	phone := strings.TrimSpace(p.Text())
+	if uri, ok := strings.CutPrefix(strings.ToLower(phone), "tel:"); ok {
+		phone, _, _ = strings.Cut(uri, ";")
+	}
+	return phone
+}
+
+func cardLabel(p vcard.Property) cmv1.Label {
+	for _, t := range p.Types() {
+		switch t {
+		case "work":
+			return cmv1.Label_LABEL_WORK
+		case "home":
+			return cmv1.Label_LABEL_HOME
+		case "cell", "mobile", "iphone":
+			return cmv1.Label_LABEL_MOBILE
+		}
+	}
+	return cmv1.Label_LABEL_OTHER
+}
+
+func contactToCard(contact models.Contact, version string) vcard.Card {
+	card := vcard.Card{Version: version}
+	card.Add(vcard.NewText("FN", contact.Name))
+	family, given := splitName(contact.Name)
+	card.Add(vcard.NewStructured("N", family, given, "", "", ""))
+
+	for _, e := range contact.Emails {
+		p := vcard.NewText("EMAIL", e.Email)
+		addCardType(&p, version, cardTypes[e.Label], e.Primary)
+		card.Add(p)
+	}
+
+	for _, ph := range contact.Phones {
+		p := vcard.NewText("TEL", ph.Phone)
+		if version == vcard.Version40 {
+			p.Value = "tel:" + ph.Phone
+			p.AddParam("VALUE", "uri")
+		}
+		typ := cardTypes[ph.Label]
+		if ph.Label == models.LabelMobile {
+			typ = "cell"
+		}
+		addCardType(&p, version, typ, ph.Primary)
+		card.Add(p)
+	}
+
+	if len(contact.Tags) > 0 {
+		card.Add(vcard.NewList("CATEGORIES", contact.Tags...))
+	}
+	return card
+}
+
+// vCard has no types for other labels
+var cardTypes = map[models.Label]string{
+	models.LabelWork: "work",
+	models.LabelHome: "home",
+}
+
+// Marks primary values with PREF=1 in vCard 4.0 and with TYPE=pref in vCard 3.0
+func addCardType(p *vcard.Property, version, typ string, primary bool) {
+	if typ != "" {
+		p.AddParam("TYPE", typ)
+	}
+	if !primary {
+		return
+	}
+	if version == vcard.Version40 {
+		p.AddParam("PREF", "1")
+	} else {
+		p.AddParam("TYPE", "pref")
+	}
+}
+
+// Splits name into family and given names treating the last word as family name
+func splitName(name string) (family, given string) {
+	words := strings.Fields(name)
+	if len(words) < 2 {
+		return "", name
+	}
+	return words[len(words)-1], strings.Join(words[:len(words)-1], " ")
+}
@@ -0,0 +1,99 @@
+package cm
+
+import (
+	"bytes"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/vcard"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/protobuf/proto"
+	"strings"
+	"testing"
+)
+
+func TestContactCardRoundTrip(t *testing.T) {
+	contact := models.Contact{
+		Name: "Анна Мария Иванова-Петрова",
+		Emails: []models.ContactEmail{
+			{Email: "anna@work.example", Label: models.LabelWork},
+			{Email: "anna@home.example", Label: models.LabelHome, Primary: true},
+		},
+		Phones: []models.ContactPhone{
+			{Phone: "+79123456789", Label: models.LabelMobile, Primary: true},
+			{Phone: "+74951234567", Label: models.LabelOther},
+		},
+		Tags: []string{"friends", "a,b"},
+	}
+	want := &cmv1.CreateContactRequest{
+		Name: contact.Name,
+		Emails: []*cmv1.LabeledEmail{
+			{Email: "anna@work.example", Label: cmv1.Label_LABEL_WORK},
+			{Email: "anna@home.example", Label: cmv1.Label_LABEL_HOME, Primary: true},
+		},
+		Phones: []*cmv1.LabeledPhone{
+			{Phone: "+79123456789", Label: cmv1.Label_LABEL_MOBILE, Primary: true},
+			{Phone: "+74951234567", Label: cmv1.Label_LABEL_OTHER},
+		},
+	}
+
+	for _, version := range []string{vcard.Version30, vcard.Version40} {
+		t.Run(version, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := vcard.Encode(&buf, contactToCard(contact, version)); err != nil {
+				t.Fatal(err)
+			}
+			card, err := vcard.NewReader(&buf).Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := cardToCreateRequest(card); !proto.Equal(got, want) {
+				t.Errorf("cardToCreateRequest() = %v, want %v", got, want)
+			}
+			categories, _ := card.Get("CATEGORIES")
+			if categories.Value != `friends,a\,b` {
+				t.Errorf("CATEGORIES = %q", categories.Value)
+			}
+		})
+	}
+}
+
+func TestCardToCreateRequestKeepsEmailAndPhoneWithSameText(t *testing.T) {
+	data := "BEGIN:VCARD\r\n" +
+		"VERSION:3.0\r\n" +
+		"FN:Ivan\r\n" +
+		"EMAIL:12345\r\n" +
+		"EMAIL:12345\r\n" +
+		"TEL;TYPE=pref:12345\r\n" +
+		"TEL:12345\r\n" +
+		"END:VCARD\r\n"
+	card, err := vcard.NewReader(strings.NewReader(data)).Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := cardToCreateRequest(card)
+	if len(req.GetEmails()) != 1 || len(req.GetPhones()) != 1 {
+		t.Fatalf("cardToCreateRequest() = %v, want one email and one phone", req)
+	}
+	if !req.GetPhones()[0].GetPrimary() {
+		t.Errorf("phone is not primary")
+	}
+}
+
+func TestAddImportResultCapsResults(t *testing.T) {
+	resp := &cmv1.ImportContactsResponse{}
+	for i := 0; i < maxImportResults+10; i++ {
+		result := &cmv1.ImportResult{Index: int32(i)}
+		if i%2 == 1 {
+			result.Error = fmt.Sprint("row ", i)
+		}
+		addImportResult(resp, result)
+	}
+
+	if len(resp.GetResults()) != maxImportResults {
+		t.Errorf("len(results) = %d, want %d", len(resp.GetResults()), maxImportResults)
+	}
+	if resp.GetImported()+resp.GetFailed() != maxImportResults+10 || resp.GetFailed() != (maxImportResults+10)/2 {
+		t.Errorf("imported = %d, failed = %d", resp.GetImported(), resp.GetFailed())
+	}
+}
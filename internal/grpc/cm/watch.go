@@ -0,0 +1,57 @@
+package cm
+
+import (
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/service/cm"
+	cmv1 "github.com/tendze/gRPC_ContactManager_Protos/gen/go/cm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var contactEventTypes = map[models.ContactEventType]cmv1.ContactEventType{
+	models.ContactCreated: cmv1.ContactEventType_CONTACT_EVENT_TYPE_CREATED,
+	models.ContactUpdated: cmv1.ContactEventType_CONTACT_EVENT_TYPE_UPDATED,
+	models.ContactDeleted: cmv1.ContactEventType_CONTACT_EVENT_TYPE_DELETED,
+}
+
+func (s *serverAPI) WatchContacts(
+	req *cmv1.WatchContactsRequest,
+	stream cmv1.ContactManager_WatchContactsServer,
+) error {
+	if req.GetAfterEventId() < 0 {
+		return status.Error(codes.InvalidArgument, "after_event_id must not be negative")
+	}
+
+	ctx := stream.Context()
+	ownerKey, err := s.addressBook(ctx, req.GetAddressBookId(), models.RoleViewer)
+	if err != nil {
+		return err
+	}
+
+	err = s.cm.WatchContacts(ctx, ownerKey, req.GetAfterEventId(), func(event models.ContactEvent) error {
+		return stream.Send(&cmv1.ContactEvent{
+			Id:        event.ID,
+			Type:      contactEventTypes[event.Type],
+			ContactId: event.ContactID,
+			Contact:   snapshotToProto(event.Contact),
+			CreatedAt: event.CreatedAt.Unix(),
+		})
+	})
+	if err != nil {
+		if errors.Is(err, cm.ErrEventsExpired) {
+			return status.Error(codes.FailedPrecondition, "events after the given id are no longer available, read contacts anew")
+		}
+		if errors.Is(err, cm.ErrWatchLagged) {
+			return status.Error(codes.ResourceExhausted, "watcher fell behind, resume from the last received event")
+		}
+		if errors.Is(err, cm.ErrWatchClosed) {
+			return status.Error(codes.Unavailable, "server is shutting down, resume from the last received event")
+		}
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Error(codes.Internal, "cannot watch contacts")
+	}
+	return nil
+}
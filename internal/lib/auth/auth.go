@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+// Permission is a set of operations allowed to a user
+type Permission uint
+
+const (
+	// PermRead allows reading contacts the user has access to
+	PermRead Permission = 1 << iota
+	// PermWrite allows changing contacts the user has access to
+	PermWrite
+	// PermReadAll allows reading contacts of any owner
+	PermReadAll
+)
+
+// Role is granted to a user by SSO, permissions of the user are the union of permissions of the roles
+type Role string
+
+const (
+	// RoleReader is granted for tokens limited to reading
+	RoleReader Role = "reader"
+	RoleUser   Role = "user"
+	// RoleAdmin is granted to SSO administrators in addition to one of the other roles
+	RoleAdmin Role = "admin"
+)
+
+var rolePermissions = map[Role]Permission{
+	RoleReader: PermRead,
+	RoleUser:   PermRead | PermWrite,
+	RoleAdmin:  PermReadAll,
+}
+
+// Principal is the user a request is made by, as authenticated by SSO
+type Principal struct {
+	// UserID is the stable SSO id of the user, data of the user is keyed by it
+	UserID int64
+	// Email may change in SSO, it is used to match shares and address book memberships
+	Email string
+	AppID int
+	Roles []Role
+	// AdminCheck tells whether the user is an admin when the token doesn't, nil if it does.
+	// It may call SSO, so it is called only when a request needs admin permissions
+	AdminCheck func(ctx context.Context) bool
+}
+
+// Can tells whether roles of the principal grant all of perm
+func (p Principal) Can(perm Permission) bool {
+	var granted Permission
+	for _, role := range p.Roles {
+		granted |= rolePermissions[role]
+	}
+	return granted&perm == perm
+}
+
+// Allows is Can that also asks AdminCheck when roles of the principal
+// don't grant perm, but admin role would
+func (p Principal) Allows(ctx context.Context, perm Permission) bool {
+	if p.Can(perm) {
+		return true
+	}
+	if p.AdminCheck == nil {
+		return false
+	}
+	admin := Principal{Roles: append(slices.Clone(p.Roles), RoleAdmin)}
+	return admin.Can(perm) && p.AdminCheck(ctx)
+}
+
+// HasRole tells whether the principal is granted the role
+func (p Principal) HasRole(role Role) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns ctx of a request made by p
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal of the request, if it is authenticated
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
@@ -0,0 +1,176 @@
+package contactcsv
+
+import (
+	"bytes"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestProfilesRoundTrip(t *testing.T) {
+	contact := models.Contact{
+		Name: "Анна Мария Петрова",
+		Emails: []models.ContactEmail{
+			{Email: "anna@work.example", Label: models.LabelWork},
+			{Email: "anna@home.example", Label: models.LabelHome, Primary: true},
+		},
+		Phones: []models.ContactPhone{
+			{Phone: "+74951234567", Label: models.LabelWork},
+			{Phone: "+79123456789", Label: models.LabelMobile, Primary: true},
+		},
+		Tags: []string{"friends", "family"},
+	}
+
+	tests := []struct {
+		name string
+		m    Mapping
+		want Row
+	}{
+		{"google", Google, Row{
+			Name: contact.Name,
+			// primary values go first, labels are kept in label columns
+			Emails: []models.ContactEmail{
+				{Email: "anna@home.example", Label: models.LabelHome},
+				{Email: "anna@work.example", Label: models.LabelWork},
+			},
+			Phones: []models.ContactPhone{
+				{Phone: "+79123456789", Label: models.LabelMobile},
+				{Phone: "+74951234567", Label: models.LabelWork},
+			},
+			Tags: contact.Tags,
+		}},
+		{"outlook", Outlook, Row{
+			Name: contact.Name,
+			// Outlook has no labels of emails, phones go to the columns of their labels
+			Emails: []models.ContactEmail{
+				{Email: "anna@home.example", Label: models.LabelOther},
+				{Email: "anna@work.example", Label: models.LabelOther},
+			},
+			Phones: []models.ContactPhone{
+				{Phone: "+79123456789", Label: models.LabelMobile},
+				{Phone: "+74951234567", Label: models.LabelWork},
+			},
+			Tags: contact.Tags,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewWriter(&buf, tt.m)
+			if err := w.Write(contact); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatal(err)
+			}
+
+			r, err := NewReader(&buf, tt.m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := r.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Next() = %+v, want %+v", got, tt.want)
+			}
+			if _, err = r.Next(); !errors.Is(err, io.EOF) {
+				t.Errorf("second Next() error = %v, want EOF", err)
+			}
+		})
+	}
+}
+
+func TestReaderGoogleExport(t *testing.T) {
+	data := "\ufeffFirst Name,Last Name,E-mail 1 - Label,E-mail 1 - Value,Labels\n" +
+		"Ivan,Petrov,* Work,ivan@work.example ::: ivan@example.com,* myContacts ::: Friends ::: starred\n"
+	r, err := NewReader(strings.NewReader(data), Google)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := r.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Row{
+		Name: "Ivan Petrov",
+		Emails: []models.ContactEmail{
+			{Email: "ivan@work.example", Label: models.LabelWork},
+			{Email: "ivan@example.com", Label: models.LabelWork},
+		},
+		Tags: []string{"Friends"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Next() = %+v, want %+v", got, want)
+	}
+}
+
+func TestReaderCustomMapping(t *testing.T) {
+	m := Mapping{
+		Name:         []string{"Full Name"},
+		Emails:       []Field{{Column: "Mail", LabelColumn: "Mail Type", Label: models.LabelOther}},
+		Phones:       []Field{{Column: "Tel", Label: models.LabelMobile}},
+		Tags:         "Groups",
+		TagSeparator: "|",
+	}
+	data := "full name,Tel,Mail Type,Mail,Groups\n" +
+		"Ivan,+79123456789,Business,ivan@work.example,a | b c||\n" +
+		"Anna,,Unknown,anna@example.com,\n"
+	r, err := NewReader(strings.NewReader(data), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Row{
+		{
+			Name:   "Ivan",
+			Emails: []models.ContactEmail{{Email: "ivan@work.example", Label: models.LabelWork}},
+			Phones: []models.ContactPhone{{Phone: "+79123456789", Label: models.LabelMobile}},
+			Tags:   []string{"a", "b c"},
+		},
+		{
+			Name:   "Anna",
+			Emails: []models.ContactEmail{{Email: "anna@example.com", Label: models.LabelOther}},
+		},
+	}
+	for i := range want {
+		got, err := r.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("row %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+
+	// the writer puts the same columns back
+	var buf bytes.Buffer
+	w := NewWriter(&buf, m)
+	if err = w.Write(models.Contact{Name: "Ivan", Tags: []string{"a", "b c"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if want := "Full Name,Mail Type,Mail,Tel,Groups\nIvan,,,,a|b c\n"; buf.String() != want {
+		t.Errorf("written %q, want %q", buf.String(), want)
+	}
+}
+
+func TestNewReaderErrors(t *testing.T) {
+	if _, err := NewReader(strings.NewReader(""), Default); !errors.Is(err, ErrNoData) {
+		t.Errorf("empty data: error = %v, want %v", err, ErrNoData)
+	}
+	if _, err := NewReader(strings.NewReader("Email 1 - Value,Tags\n"), Default); !errors.Is(err, ErrNoNameColumn) {
+		t.Errorf("no name column: error = %v, want %v", err, ErrNoNameColumn)
+	}
+	// any of the name columns is enough
+	if _, err := NewReader(strings.NewReader("Last Name\n"), Google); err != nil {
+		t.Errorf("last name only: error = %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package contactcsv
+
+import (
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"slices"
+	"strings"
+)
+
+// Mapping tells which CSV columns hold contact fields
+type Mapping struct {
+	// Columns joined with spaces into the contact name
+	Name   []string
+	Emails []Field
+	Phones []Field
+	Tags   string
+	// Separator of tags in the tags column, "," when empty
+	TagSeparator string
+	// Separator of several values in one cell, e.g. " ::: " of Google Contacts
+	ValueSeparator string
+	// Tags that are not imported, e.g. system labels of Google Contacts
+	SkipTags []string
+}
+
+// Field is a column with email or phone
+type Field struct {
+	Column string
+	// Column with label text, Label is used when it is empty
+	LabelColumn string
+	Label       models.Label
+}
+
+// Built-in profiles
+var (
+	Default = Mapping{
+		Name:         []string{"Name"},
+		Emails:       numberedFields("Email %d - Value", "Email %d - Label", 3),
+		Phones:       numberedFields("Phone %d - Value", "Phone %d - Label", 3),
+		Tags:         "Tags",
+		TagSeparator: ",",
+	}
+	Google = Mapping{
+		Name:           []string{"First Name", "Middle Name", "Last Name"},
+		Emails:         numberedFields("E-mail %d - Value", "E-mail %d - Label", 3),
+		Phones:         numberedFields("Phone %d - Value", "Phone %d - Label", 4),
+		Tags:           "Labels",
+		TagSeparator:   " ::: ",
+		ValueSeparator: " ::: ",
+		SkipTags:       []string{"myContacts", "starred"},
+	}
+	Outlook = Mapping{
+		Name: []string{"First Name", "Middle Name", "Last Name"},
+		Emails: []Field{
+			{Column: "E-mail Address", Label: models.LabelOther},
+			{Column: "E-mail 2 Address", Label: models.LabelOther},
+			{Column: "E-mail 3 Address", Label: models.LabelOther},
+		},
+		Phones: []Field{
+			{Column: "Primary Phone", Label: models.LabelOther},
+			{Column: "Mobile Phone", Label: models.LabelMobile},
+			{Column: "Business Phone", Label: models.LabelWork},
+			{Column: "Business Phone 2", Label: models.LabelWork},
+			{Column: "Home Phone", Label: models.LabelHome},
+			{Column: "Home Phone 2", Label: models.LabelHome},
+			{Column: "Other Phone", Label: models.LabelOther},
+		},
+		Tags:         "Categories",
+		TagSeparator: ";",
+	}
+)
+
+var labelNames = map[models.Label]string{
+	models.LabelWork:   "Work",
+	models.LabelHome:   "Home",
+	models.LabelMobile: "Mobile",
+	models.LabelOther:  "Other",
+}
+
+func numberedFields(column, labelColumn string, n int) []Field {
+	fields := make([]Field, 0, n)
+	for i := 1; i <= n; i++ {
+		fields = append(fields, Field{
+			Column:      fmt.Sprintf(column, i),
+			LabelColumn: fmt.Sprintf(labelColumn, i),
+			Label:       models.LabelOther,
+		})
+	}
+	return fields
+}
+
+func (m Mapping) tagSeparator() string {
+	if m.TagSeparator == "" {
+		return ","
+	}
+	return m.TagSeparator
+}
+
+func (m Mapping) header() []string {
+	header := slices.Clone(m.Name)
+	for _, f := range slices.Concat(m.Emails, m.Phones) {
+		if f.LabelColumn != "" {
+			header = append(header, f.LabelColumn)
+		}
+		header = append(header, f.Column)
+	}
+	if m.Tags != "" {
+		header = append(header, m.Tags)
+	}
+	return header
+}
+
+// Positions of header columns by lower-cased name
+type columnIndex map[string]int
+
+func columns(header []string) columnIndex {
+	columns := make(columnIndex, len(header))
+	for i, c := range header {
+		c = strings.ToLower(strings.TrimSpace(c))
+		if _, ok := columns[c]; !ok {
+			columns[c] = i
+		}
+	}
+	return columns
+}
+
+func (c columnIndex) has(column string) bool {
+	_, ok := c[strings.ToLower(column)]
+	return ok
+}
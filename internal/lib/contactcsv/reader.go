@@ -0,0 +1,155 @@
+package contactcsv
+
+import (
+	"encoding/csv"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"io"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrNoData       = errors.New("CSV data required")
+	ErrNoNameColumn = errors.New("CSV header has no name column of the mapping")
+)
+
+// Row is a contact read from a CSV row, its values are not validated
+type Row struct {
+	Name   string
+	Emails []models.ContactEmail
+	Phones []models.ContactPhone
+	Tags   []string
+}
+
+// Reader reads contacts from CSV with header in the first line
+type Reader struct {
+	m       Mapping
+	r       *csv.Reader
+	columns columnIndex
+}
+
+// NewReader reads the header. It returns ErrNoData when there is no header,
+// ErrNoNameColumn when the header has none of the name columns of the mapping
+// and *csv.ParseError when the header is malformed
+func NewReader(r io.Reader, m Mapping) (*Reader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, ErrNoData
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	columns := columns(header)
+	if !slices.ContainsFunc(m.Name, func(c string) bool { return columns.has(c) }) {
+		return nil, ErrNoNameColumn
+	}
+	return &Reader{m: m, r: cr, columns: columns}, nil
+}
+
+// Next returns the next row. It returns io.EOF when there are no more rows.
+// Malformed rows are reported with *csv.ParseError and reading can go on after them
+func (r *Reader) Next() (Row, error) {
+	record, err := r.r.Read()
+	if err != nil {
+		return Row{}, err
+	}
+
+	row := row{columns: r.columns, record: record}
+	res := Row{Name: r.name(row), Tags: r.tags(row)}
+	for _, f := range r.m.Emails {
+		for _, email := range r.values(row, f.Column) {
+			res.Emails = append(res.Emails, models.ContactEmail{Email: email, Label: r.label(row, f)})
+		}
+	}
+	for _, f := range r.m.Phones {
+		for _, phone := range r.values(row, f.Column) {
+			res.Phones = append(res.Phones, models.ContactPhone{Phone: phone, Label: r.label(row, f)})
+		}
+	}
+	return res, nil
+}
+
+func (r *Reader) name(row row) string {
+	var parts []string
+	for _, c := range r.m.Name {
+		if v := row.get(c); v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func (r *Reader) values(row row, column string) []string {
+	v := row.get(column)
+	if v == "" {
+		return nil
+	}
+	if r.m.ValueSeparator == "" {
+		return []string{v}
+	}
+
+	var values []string
+	for _, part := range strings.Split(v, r.m.ValueSeparator) {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+func (r *Reader) label(row row, f Field) models.Label {
+	if f.LabelColumn == "" {
+		return f.Label
+	}
+	// Google Contacts marks labels with "* ", e.g. "* Work"
+	switch strings.ToLower(strings.TrimSpace(strings.TrimPrefix(row.get(f.LabelColumn), "*"))) {
+	case "work", "business":
+		return models.LabelWork
+	case "home", "personal":
+		return models.LabelHome
+	case "mobile", "cell":
+		return models.LabelMobile
+	}
+	return f.Label
+}
+
+func (r *Reader) tags(row row) []string {
+	if r.m.Tags == "" {
+		return nil
+	}
+	v := row.get(r.m.Tags)
+	if v == "" {
+		return nil
+	}
+
+	var tags []string
+	for _, tag := range strings.Split(v, r.m.tagSeparator()) {
+		tag = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(tag), "* "))
+		if tag != "" && !slices.Contains(r.m.SkipTags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+type row struct {
+	columns columnIndex
+	record  []string
+}
+
+// Returns trimmed value of column or empty string if there is no such column
+func (r row) get(column string) string {
+	i, ok := r.columns[strings.ToLower(column)]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[i])
+}
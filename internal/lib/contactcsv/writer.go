@@ -0,0 +1,156 @@
+package contactcsv
+
+import (
+	"encoding/csv"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"io"
+	"slices"
+	"strings"
+)
+
+// Writer writes contacts as CSV rows with columns of a mapping.
+// Values that do not fit into the columns are dropped
+type Writer struct {
+	m       Mapping
+	w       *csv.Writer
+	header  []string
+	columns columnIndex
+	started bool
+}
+
+func NewWriter(w io.Writer, m Mapping) *Writer {
+	header := m.header()
+	return &Writer{
+		m:       m,
+		w:       csv.NewWriter(w),
+		header:  header,
+		columns: columns(header),
+	}
+}
+
+// Write writes the contact, the header goes before the first one
+func (w *Writer) Write(contact models.Contact) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	return w.w.Write(w.record(contact))
+}
+
+// Flush writes the header if no contacts were written and any buffered data
+func (w *Writer) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *Writer) writeHeader() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+	return w.w.Write(w.header)
+}
+
+func (w *Writer) record(contact models.Contact) []string {
+	record := make([]string, len(w.header))
+	set := func(column, value string) {
+		if i, ok := w.columns[strings.ToLower(column)]; ok {
+			record[i] = value
+		}
+	}
+
+	for i, part := range splitName(contact.Name, len(w.m.Name)) {
+		set(w.m.Name[i], part)
+	}
+
+	emails := make([]value, 0, len(contact.Emails))
+	for _, e := range contact.Emails {
+		emails = append(emails, value{value: e.Email, label: e.Label, primary: e.Primary})
+	}
+	phones := make([]value, 0, len(contact.Phones))
+	for _, p := range contact.Phones {
+		phones = append(phones, value{value: p.Phone, label: p.Label, primary: p.Primary})
+	}
+	fill := func(fields []Field, values []value) {
+		for i, v := range assignFields(fields, values) {
+			set(fields[i].Column, v.value)
+			if fields[i].LabelColumn != "" {
+				set(fields[i].LabelColumn, labelNames[v.label])
+			}
+		}
+	}
+	fill(w.m.Emails, emails)
+	fill(w.m.Phones, phones)
+
+	if w.m.Tags != "" {
+		set(w.m.Tags, strings.Join(contact.Tags, w.m.tagSeparator()))
+	}
+	return record
+}
+
+type value struct {
+	value   string
+	label   models.Label
+	primary bool
+}
+
+// Puts primary value first and then matches values to fields:
+// fields with fixed label take values with the same label,
+// fields with label column and then any free fields take the rest
+func assignFields(fields []Field, values []value) map[int]value {
+	values = slices.Clone(values)
+	slices.SortStableFunc(values, func(a, b value) int {
+		switch {
+		case a.primary == b.primary:
+			return 0
+		case a.primary:
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	res := make(map[int]value, len(fields))
+	assigned := make([]bool, len(values))
+	assign := func(match func(f Field, v value) bool) {
+		for vi, v := range values {
+			if assigned[vi] {
+				continue
+			}
+			for fi, f := range fields {
+				if _, taken := res[fi]; !taken && match(f, v) {
+					res[fi] = v
+					assigned[vi] = true
+					break
+				}
+			}
+		}
+	}
+	assign(func(f Field, v value) bool { return f.LabelColumn == "" && f.Label == v.label })
+	assign(func(f Field, _ value) bool { return f.LabelColumn != "" })
+	assign(func(Field, value) bool { return true })
+	return res
+}
+
+// Splits name into n parts: the first word, the middle words and the last word.
+// With two parts the middle words go to the first one
+func splitName(name string, n int) []string {
+	parts := make([]string, n)
+	words := strings.Fields(name)
+	if n == 1 || len(words) < 2 {
+		parts[0] = name
+		return parts
+	}
+
+	last := len(words) - 1
+	parts[n-1] = words[last]
+	if n == 2 {
+		parts[0] = strings.Join(words[:last], " ")
+		return parts
+	}
+	parts[0] = words[0]
+	parts[1] = strings.Join(words[1:last], " ")
+	return parts
+}
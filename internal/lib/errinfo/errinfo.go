@@ -0,0 +1,25 @@
+package errinfo
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain of ErrorInfo details of errors the service returns
+const Domain = "contactmanager"
+
+// Status returns status with ErrorInfo telling the reason of the error and metadata, if any.
+// Clients tell errors apart by the reason rather than by the message
+func Status(code codes.Code, msg, reason string, metadata map[string]string) *status.Status {
+	st := status.New(code, msg)
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   Domain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
@@ -0,0 +1,171 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+)
+
+// Events buffered for a subscriber that doesn't keep up with publishing
+const subscriberBuffer = 256
+
+var (
+	// ErrExpired means events after the requested id are no longer kept
+	ErrExpired = errors.New("events expired")
+	// ErrLagged means the subscriber didn't keep up and missed events
+	ErrLagged = errors.New("subscriber lagged behind")
+	ErrClosed = errors.New("bus closed")
+)
+
+// Event is a published payload with its id. The high 32 bits of the id are the epoch
+// of the bus, random for every bus, the low ones grow by one starting from 1.
+// So ids published by the bus of a previous process run are never taken for ids of this one
+type Event[T any] struct {
+	ID      int64
+	Key     string
+	Payload T
+}
+
+// Bus delivers published events to subscribers of the event's key
+// and keeps the last events, so subscribers can resume after a reconnect
+type Bus[T any] struct {
+	mu    sync.Mutex
+	epoch int64
+	// lastSeq is the low bits of the last id
+	lastSeq int64
+	// Ring of the last events, event with sequence number i is at (i-1) % len(history)
+	history []Event[T]
+	subs    map[*Subscription[T]]struct{}
+	closed  bool
+}
+
+// New creates a bus keeping historySize last events
+func New[T any](historySize int) *Bus[T] {
+	return &Bus[T]{
+		// positive, so that ids are positive and never zero
+		epoch:   rand.Int64N(1<<31-1) + 1,
+		history: make([]Event[T], max(historySize, 1)),
+		subs:    make(map[*Subscription[T]]struct{}),
+	}
+}
+
+// Publish delivers payload to subscribers of key and returns id of the event.
+// Subscribers that have no room for the event are dropped with ErrLagged
+func (b *Bus[T]) Publish(key string, payload T) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastSeq++
+	e := Event[T]{ID: b.epoch<<32 | b.lastSeq, Key: key, Payload: payload}
+	b.history[(b.lastSeq-1)%int64(len(b.history))] = e
+
+	for sub := range b.subs {
+		if sub.key != key {
+			continue
+		}
+		select {
+		case sub.events <- e:
+		default:
+			sub.err = ErrLagged
+			b.drop(sub)
+		}
+	}
+	return e.ID
+}
+
+// Subscribe starts delivery of events of key published after the event afterID.
+// Zero afterID delivers only events published from now on. Fails with ErrExpired
+// if the bus no longer keeps events after afterID or has never published afterID,
+// e.g. when afterID was published before the process restarted
+func (b *Bus[T]) Subscribe(key string, afterID int64) (*Subscription[T], error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, ErrClosed
+	}
+	afterSeq := b.lastSeq
+	if afterID != 0 {
+		if afterID>>32 != b.epoch {
+			return nil, ErrExpired
+		}
+		afterSeq = afterID & (1<<32 - 1)
+	}
+	if afterSeq > b.lastSeq || afterSeq < b.lastSeq-int64(len(b.history)) {
+		return nil, ErrExpired
+	}
+
+	sub := &Subscription[T]{
+		bus:    b,
+		key:    key,
+		events: make(chan Event[T], subscriberBuffer),
+	}
+	for seq := afterSeq + 1; seq <= b.lastSeq; seq++ {
+		if e := b.history[(seq-1)%int64(len(b.history))]; e.Key == key {
+			sub.backlog = append(sub.backlog, e)
+		}
+	}
+	b.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// Close ends all subscriptions with ErrClosed
+func (b *Bus[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subs {
+		sub.err = ErrClosed
+		b.drop(sub)
+	}
+}
+
+// Must be called with b.mu held
+func (b *Bus[T]) drop(sub *Subscription[T]) {
+	delete(b.subs, sub)
+	close(sub.events)
+}
+
+type Subscription[T any] struct {
+	bus     *Bus[T]
+	key     string
+	backlog []Event[T]
+	events  chan Event[T]
+	// Why the subscription was dropped, guarded by bus.mu
+	err error
+}
+
+// Next waits for the next event. It fails with ErrLagged or ErrClosed
+// when the subscription has been dropped by the bus
+func (s *Subscription[T]) Next(ctx context.Context) (Event[T], error) {
+	if len(s.backlog) > 0 {
+		e := s.backlog[0]
+		s.backlog = s.backlog[1:]
+		return e, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return Event[T]{}, ctx.Err()
+	case e, ok := <-s.events:
+		if !ok {
+			s.bus.mu.Lock()
+			defer s.bus.mu.Unlock()
+			return Event[T]{}, s.err
+		}
+		return e, nil
+	}
+}
+
+// Close stops delivery of events
+func (s *Subscription[T]) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	if _, ok := s.bus.subs[s]; ok {
+		s.err = ErrClosed
+		s.bus.drop(s)
+	}
+}
@@ -0,0 +1,131 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func next(t *testing.T, sub *Subscription[string]) Event[string] {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	e, err := sub.Next(ctx)
+	if err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	return e
+}
+
+func TestPublishDeliversEventsOfKey(t *testing.T) {
+	b := New[string](10)
+	sub, err := b.Subscribe("a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+
+	first := b.Publish("a", "1")
+	b.Publish("b", "other")
+	second := b.Publish("a", "2")
+
+	if second != first+2 {
+		t.Errorf("ids %d, %d: want ids growing by one per published event", first, second)
+	}
+	if e := next(t, sub); e.ID != first || e.Payload != "1" {
+		t.Errorf("first event = %+v", e)
+	}
+	if e := next(t, sub); e.ID != second || e.Payload != "2" {
+		t.Errorf("second event = %+v", e)
+	}
+}
+
+func TestSubscribeResumesAfterID(t *testing.T) {
+	b := New[string](10)
+	first := b.Publish("a", "1")
+	b.Publish("a", "2")
+	b.Publish("a", "3")
+
+	sub, err := b.Subscribe("a", first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+	for _, want := range []string{"2", "3"} {
+		if e := next(t, sub); e.Payload != want {
+			t.Errorf("payload = %q, want %q", e.Payload, want)
+		}
+	}
+}
+
+func TestSubscribeExpired(t *testing.T) {
+	b := New[string](2)
+	first := b.Publish("a", "1")
+	b.Publish("a", "2")
+	last := b.Publish("a", "3")
+	b.Publish("a", "4")
+
+	restarted := New[string](2)
+	restarted.Publish("a", "1")
+	restarted.Publish("a", "2")
+
+	tests := []struct {
+		name    string
+		bus     *Bus[string]
+		afterID int64
+		wantErr error
+	}{
+		{name: "kept", bus: b, afterID: last, wantErr: nil},
+		{name: "no longer kept", bus: b, afterID: first, wantErr: ErrExpired},
+		{name: "not published yet", bus: b, afterID: last + 2, wantErr: ErrExpired},
+		{name: "negative", bus: b, afterID: -1, wantErr: ErrExpired},
+		// the new bus has published as many events, but they are not the ones the client saw
+		{name: "published before restart", bus: restarted, afterID: first + 1, wantErr: ErrExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub, err := tt.bus.Subscribe("a", tt.afterID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Subscribe() error = %v, want %v", err, tt.wantErr)
+			}
+			if sub != nil {
+				sub.Close()
+			}
+		})
+	}
+}
+
+func TestLaggedSubscriberIsDropped(t *testing.T) {
+	b := New[string](1)
+	sub, err := b.Subscribe("a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i <= subscriberBuffer; i++ {
+		b.Publish("a", "x")
+	}
+
+	for i := 0; i < subscriberBuffer; i++ {
+		next(t, sub)
+	}
+	if _, err = sub.Next(context.Background()); !errors.Is(err, ErrLagged) {
+		t.Errorf("Next() error = %v, want %v", err, ErrLagged)
+	}
+}
+
+func TestCloseEndsSubscriptions(t *testing.T) {
+	b := New[string](1)
+	sub, err := b.Subscribe("a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Close()
+
+	if _, err = sub.Next(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Errorf("Next() error = %v, want %v", err, ErrClosed)
+	}
+	if _, err = b.Subscribe("a", 0); !errors.Is(err, ErrClosed) {
+		t.Errorf("Subscribe() error = %v, want %v", err, ErrClosed)
+	}
+}
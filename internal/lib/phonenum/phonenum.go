@@ -0,0 +1,29 @@
+package phonenum
+
+import (
+	"errors"
+	"github.com/nyaruka/phonenumbers"
+)
+
+var ErrInvalidPhone = errors.New("invalid phone number")
+
+// Normalize parses phone number written in any common format, e.g.
+// "8 (912) 345-67-89" or "+44 20 7946 0958", and returns it in E.164.
+// Numbers without country code are treated as numbers of defaultRegion
+// (ISO 3166-1 alpha-2 code, e.g. "RU")
+func Normalize(phone, defaultRegion string) (string, error) {
+	num, err := phonenumbers.Parse(phone, defaultRegion)
+	if err != nil {
+		return "", ErrInvalidPhone
+	}
+	if !phonenumbers.IsValidNumber(num) {
+		return "", ErrInvalidPhone
+	}
+	return phonenumbers.Format(num, phonenumbers.E164), nil
+}
+
+// IsSupportedRegion tells whether numbers of the region can be parsed, region is
+// ISO 3166-1 alpha-2 code in upper case
+func IsSupportedRegion(region string) bool {
+	return phonenumbers.GetSupportedRegions()[region]
+}
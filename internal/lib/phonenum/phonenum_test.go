@@ -0,0 +1,47 @@
+package phonenum
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		phone   string
+		region  string
+		want    string
+		wantErr error
+	}{
+		{phone: "8 (912) 345-67-89", region: "RU", want: "+79123456789"},
+		{phone: "+7 912 345 67 89", region: "RU", want: "+79123456789"},
+		{phone: "9123456789", region: "RU", want: "+79123456789"},
+		{phone: "+44 20 7946 0958", region: "RU", want: "+442079460958"},
+		{phone: "020 7946 0958", region: "GB", want: "+442079460958"},
+		{phone: "(201) 555-0123", region: "US", want: "+12015550123"},
+		{phone: "+1 201-555-0123", region: "", want: "+12015550123"},
+		{phone: "12345", region: "RU", wantErr: ErrInvalidPhone},
+		{phone: "not a phone", region: "RU", wantErr: ErrInvalidPhone},
+		{phone: "", region: "RU", wantErr: ErrInvalidPhone},
+		{phone: "9123456789", region: "", wantErr: ErrInvalidPhone},
+		{phone: "9123456789", region: "XX", wantErr: ErrInvalidPhone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.phone+"/"+tt.region, func(t *testing.T) {
+			got, err := Normalize(tt.phone, tt.region)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("Normalize() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSupportedRegion(t *testing.T) {
+	for region, want := range map[string]bool{"RU": true, "GB": true, "US": true, "ru": false, "XX": false, "": false} {
+		if got := IsSupportedRegion(region); got != want {
+			t.Errorf("IsSupportedRegion(%q) = %v, want %v", region, got, want)
+		}
+	}
+}
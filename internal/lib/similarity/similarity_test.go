@@ -0,0 +1,78 @@
+package similarity
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTrigram(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{a: "word", b: "word", want: 1},
+		// the same as pg_trgm similarity('word', 'two words')
+		{a: "word", b: "two words", want: 4.0 / 11},
+		{a: "Ivan Petrov", b: "Petrov Ivan", want: 1},
+		{a: "ab", b: "ab", want: 1},
+		{a: "abc", b: "xyz", want: 0},
+		{a: "Пётр", b: "Пётр", want: 1},
+		{a: "", b: "word", want: 0},
+		{a: " ", b: " ", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := Trigram(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Trigram() = %v, want %v", got, tt.want)
+			}
+			if got := Trigram(tt.b, tt.a); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Trigram() swapped = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "kitten", b: "sitting", want: 3},
+		{a: "flaw", b: "lawn", want: 2},
+		{a: "", b: "abc", want: 3},
+		{a: "abc", b: "abc", want: 0},
+		// runes, not bytes, are compared
+		{a: "Пётр", b: "Петр", want: 1},
+		{a: "Иван", b: "Ivan", want: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := Levenshtein(tt.a, tt.b); got != tt.want {
+				t.Errorf("Levenshtein() = %d, want %d", got, tt.want)
+			}
+			if got := Levenshtein(tt.b, tt.a); got != tt.want {
+				t.Errorf("Levenshtein() swapped = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEdit(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{a: "kitten", b: "sitting", want: 1 - 3.0/7},
+		{a: "Пётр", b: "Петр", want: 0.75},
+		{a: "", b: "", want: 1},
+		{a: "abc", b: "", want: 0},
+		{a: "abc", b: "abc", want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := Edit(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Edit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
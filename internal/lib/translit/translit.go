@@ -0,0 +1,77 @@
+package translit
+
+import (
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"unicode"
+)
+
+// Romanization of Cyrillic letters by ICAO Doc 9303
+// (the one used in Russian passports since 2014)
+var icao = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "ie", 'ы': "y", 'ь': "", 'э': "e", 'ю': "iu", 'я': "ia",
+	'є': "ie", 'і': "i", 'ї': "i", 'ґ': "g",
+}
+
+// Spellings that differ between romanization systems
+// and common Latin spellings of Russian names
+var latinVariants = strings.NewReplacer(
+	"kh", "h",
+	"ph", "f",
+	"x", "ks",
+	"y", "i",
+	"j", "i",
+	"w", "v",
+	"q", "k",
+)
+
+// Romanize lowercases s and transliterates its Cyrillic letters to Latin by ICAO.
+// Other characters are kept as is
+func Romanize(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if latin, ok := icao[r]; ok {
+			b.WriteString(latin)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Key returns a script-independent search key of a name, so that
+// "Petrov", "PETROV" and "Петров" have the same key.
+// Diacritics are dropped, spelling variants (y/i, kh/h, x/ks, ...)
+// and doubled letters are folded, whitespace is collapsed
+func Key(s string) string {
+	// ё and й are romanized as ordinary letters, so they must
+	// be handled before diacritics are stripped
+	s = Romanize(s)
+	s, _, _ = transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), s)
+	s = latinVariants.Replace(s)
+
+	var (
+		b    strings.Builder
+		prev rune
+	)
+	for _, word := range strings.Fields(s) {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+			prev = ' '
+		}
+		for _, r := range word {
+			if r == prev && unicode.IsLetter(r) {
+				continue
+			}
+			b.WriteRune(r)
+			prev = r
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,58 @@
+package translit
+
+import "testing"
+
+func TestRomanize(t *testing.T) {
+	tests := map[string]string{
+		"Петров":     "petrov",
+		"Щукин":      "shchukin",
+		"Ёлкин Юрий": "elkin iurii",
+		"Объект":     "obieekt",
+		"Ґалина":     "galina",
+		"John Smith": "john smith",
+		"":           "",
+	}
+	for in, want := range tests {
+		if got := Romanize(in); got != want {
+			t.Errorf("Romanize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestKey(t *testing.T) {
+	// names of a group have the same key
+	groups := [][]string{
+		{"Petrov", "PETROV", "Петров", " petrov "},
+		{"Юрий", "Yuriy", "Yurij", "Iurii"},
+		{"Хабаров", "Habarov", "Khabarov"},
+		{"Алексей", "Alexey", "Aleksei"},
+		{"Анна Мария", "Anna  Maria", "ANNA MARIYA"},
+		{"José Müller", "Jose Muller", "jose muler"},
+		{"Фёдор", "Федор", "Fedor", "FEDOR"},
+	}
+	for _, group := range groups {
+		want := Key(group[0])
+		for _, name := range group[1:] {
+			if got := Key(name); got != want {
+				t.Errorf("Key(%q) = %q, want %q as of %q", name, got, want, group[0])
+			}
+		}
+	}
+
+	tests := map[string]string{
+		"Анна Мария": "ana maria",
+		"Хабаров":    "habarov",
+		// letters are folded within words only
+		"Ann Nikita": "an nikita",
+		"":           "",
+	}
+	for in, want := range tests {
+		if got := Key(in); got != want {
+			t.Errorf("Key(%q) = %q, want %q", in, got, want)
+		}
+	}
+
+	if Key("Petrov") == Key("Petrova") {
+		t.Errorf("Key() is the same for different names")
+	}
+}
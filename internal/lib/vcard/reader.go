@@ -0,0 +1,295 @@
+package vcard
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MaxCardSize limits the size of a single card, so one huge card (e.g. with embedded photo)
+// cannot exhaust memory. Larger cards are skipped with SyntaxError
+const MaxCardSize = 1 << 20
+
+// SyntaxError describes malformed card. Reader skips the rest of such card,
+// so reading can be continued
+type SyntaxError struct {
+	Line int
+	Msg  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// Reader reads cards one by one from a stream of vCard 3.0 or 4.0 data
+type Reader struct {
+	r    *bufio.Reader
+	line int
+
+	// Physical line read ahead to check for folding
+	next    string
+	nextErr error
+	hasNext bool
+
+	// Logical line returned back by unread
+	pending    string
+	pendingNum int
+	hasPending bool
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Next returns the next card. It returns io.EOF when there are no more cards.
+// Malformed cards are reported with *SyntaxError, other errors come from the underlying reader
+func (r *Reader) Next() (Card, error) {
+	if err := r.skipToBegin(); err != nil {
+		return Card{}, err
+	}
+
+	var (
+		card     Card
+		size     int
+		firstErr *SyntaxError
+	)
+	for {
+		line, num, err := r.readLine()
+		if errors.Is(err, io.EOF) {
+			return Card{}, &SyntaxError{Line: num, Msg: "missing END:VCARD"}
+		}
+		if err != nil {
+			return Card{}, err
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		size += len(line)
+		if size > MaxCardSize && firstErr == nil {
+			firstErr = &SyntaxError{Line: num, Msg: "card is too large"}
+		}
+		if firstErr != nil && !isEnd(line) {
+			if isBegin(line) {
+				r.unread(line, num)
+				return Card{}, firstErr
+			}
+			continue
+		}
+
+		p, err := parseLine(line)
+		if err != nil {
+			firstErr = &SyntaxError{Line: num, Msg: err.Error()}
+			continue
+		}
+
+		switch {
+		case p.Name == "BEGIN" && strings.EqualFold(p.Value, "VCARD"):
+			r.unread(line, num)
+			return Card{}, &SyntaxError{Line: num, Msg: "missing END:VCARD"}
+		case p.Name == "END" && strings.EqualFold(p.Value, "VCARD"):
+			if firstErr != nil {
+				return Card{}, firstErr
+			}
+			switch card.Version {
+			case Version30, Version40:
+				return card, nil
+			case "":
+				return Card{}, &SyntaxError{Line: num, Msg: "missing VERSION"}
+			default:
+				return Card{}, &SyntaxError{Line: num, Msg: fmt.Sprintf("unsupported version %q", card.Version)}
+			}
+		case p.Name == "VERSION":
+			card.Version = strings.TrimSpace(p.Value)
+		default:
+			card.Add(p)
+		}
+	}
+}
+
+// Skips lines up to BEGIN:VCARD. Anything but blank lines there is a syntax error
+func (r *Reader) skipToBegin() error {
+	var junk *SyntaxError
+	for {
+		line, num, err := r.readLine()
+		if err != nil {
+			if junk != nil && errors.Is(err, io.EOF) {
+				return junk
+			}
+			return err
+		}
+		if isBegin(line) {
+			if junk != nil {
+				r.unread(line, num)
+				return junk
+			}
+			return nil
+		}
+		if junk == nil && strings.TrimSpace(line) != "" {
+			junk = &SyntaxError{Line: num, Msg: "expected BEGIN:VCARD"}
+		}
+	}
+}
+
+func (r *Reader) unread(line string, num int) {
+	r.pending, r.pendingNum, r.hasPending = line, num, true
+}
+
+// Returns logical line with folded continuation lines joined
+// and the number of its first physical line
+func (r *Reader) readLine() (string, int, error) {
+	if r.hasPending {
+		r.hasPending = false
+		return r.pending, r.pendingNum, nil
+	}
+
+	line, err := r.readPhysical()
+	if err != nil {
+		return "", r.line, err
+	}
+	num := r.line
+
+	var b *strings.Builder
+	for {
+		next, err := r.peekPhysical()
+		if err != nil || next == "" || (next[0] != ' ' && next[0] != '\t') {
+			break
+		}
+		_, _ = r.readPhysical()
+		if b == nil {
+			b = &strings.Builder{}
+			b.WriteString(line)
+		}
+		// Do not grow the line past the card limit, the card is rejected anyway
+		if b.Len() <= MaxCardSize {
+			b.WriteString(next[1:])
+		}
+	}
+	if b != nil {
+		line = b.String()
+	}
+	return line, num, nil
+}
+
+func (r *Reader) readPhysical() (string, error) {
+	if r.hasNext {
+		r.hasNext = false
+		if r.nextErr == nil {
+			r.line++
+		}
+		return r.next, r.nextErr
+	}
+	line, err := r.read()
+	if err == nil {
+		r.line++
+	}
+	return line, err
+}
+
+func (r *Reader) peekPhysical() (string, error) {
+	if !r.hasNext {
+		r.next, r.nextErr = r.read()
+		r.hasNext = true
+	}
+	return r.next, r.nextErr
+}
+
+func (r *Reader) read() (string, error) {
+	line, err := r.r.ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// Parses content line "[group.]name *(;param[=value[,value]]):value"
+func parseLine(line string) (Property, error) {
+	i := strings.IndexAny(line, ";:")
+	if i <= 0 {
+		return Property{}, errors.New("malformed content line")
+	}
+
+	var p Property
+	name := line[:i]
+	if dot := strings.LastIndexByte(name, '.'); dot >= 0 {
+		p.Group, name = name[:dot], name[dot+1:]
+	}
+	if !isName(name) {
+		return Property{}, fmt.Errorf("invalid property name %q", name)
+	}
+	p.Name = strings.ToUpper(name)
+
+	for line[i] == ';' {
+		i++
+		j := i
+		for j < len(line) && line[j] != '=' && line[j] != ';' && line[j] != ':' {
+			j++
+		}
+		if j == len(line) {
+			return Property{}, errors.New("missing ':'")
+		}
+		param := line[i:j]
+		if !isName(param) {
+			return Property{}, fmt.Errorf("invalid parameter name %q", param)
+		}
+		i = j
+		if line[i] != '=' {
+			// vCard 2.1 style parameter without value, e.g. "TEL;CELL:..."
+			p.AddParam("TYPE", param)
+			continue
+		}
+
+		for {
+			i++
+			var value string
+			if i < len(line) && line[i] == '"' {
+				end := strings.IndexByte(line[i+1:], '"')
+				if end < 0 {
+					return Property{}, errors.New("unterminated quoted parameter value")
+				}
+				value = line[i+1 : i+1+end]
+				i += end + 2
+			} else {
+				j := i
+				for j < len(line) && line[j] != ',' && line[j] != ';' && line[j] != ':' {
+					j++
+				}
+				value, i = line[i:j], j
+			}
+			if i >= len(line) {
+				return Property{}, errors.New("missing ':'")
+			}
+			p.AddParam(param, value)
+			if line[i] != ',' {
+				break
+			}
+		}
+	}
+	if line[i] != ':' {
+		return Property{}, errors.New("missing ':'")
+	}
+	p.Value = line[i+1:]
+	return p, nil
+}
+
+func isName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !(c == '-' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isBegin(line string) bool {
+	return strings.EqualFold(strings.TrimSpace(line), "BEGIN:VCARD")
+}
+
+func isEnd(line string) bool {
+	return strings.EqualFold(strings.TrimSpace(line), "END:VCARD")
+}
@@ -0,0 +1,179 @@
+package vcard
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Supported vCard versions
+const (
+	Version30 = "3.0"
+	Version40 = "4.0"
+)
+
+// Card is a single vCard. VERSION, BEGIN and END lines are not kept in Properties
+type Card struct {
+	Version    string
+	Properties []Property
+}
+
+// Property is a content line of vCard, e.g. "item1.EMAIL;TYPE=work:john@doe.com".
+// Name and parameter names are upper-cased, Value is kept escaped as in the card
+type Property struct {
+	Group  string
+	Name   string
+	Params map[string][]string
+	Value  string
+}
+
+// Add appends property to the card
+func (c *Card) Add(p Property) {
+	c.Properties = append(c.Properties, p)
+}
+
+// Get returns the first property with the given name
+func (c *Card) Get(name string) (Property, bool) {
+	name = strings.ToUpper(name)
+	for _, p := range c.Properties {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Property{}, false
+}
+
+// All returns all properties with the given name
+func (c *Card) All(name string) []Property {
+	name = strings.ToUpper(name)
+	var res []Property
+	for _, p := range c.Properties {
+		if p.Name == name {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// NewText returns property with escaped text value
+func NewText(name, value string) Property {
+	return Property{Name: strings.ToUpper(name), Value: EscapeText(value)}
+}
+
+// NewStructured returns property with value made of ';'-separated components, e.g. N
+func NewStructured(name string, components ...string) Property {
+	escaped := make([]string, len(components))
+	for i, c := range components {
+		escaped[i] = EscapeText(c)
+	}
+	return Property{Name: strings.ToUpper(name), Value: strings.Join(escaped, ";")}
+}
+
+// NewList returns property with value made of ','-separated items, e.g. CATEGORIES
+func NewList(name string, items ...string) Property {
+	escaped := make([]string, len(items))
+	for i, item := range items {
+		escaped[i] = EscapeText(item)
+	}
+	return Property{Name: strings.ToUpper(name), Value: strings.Join(escaped, ",")}
+}
+
+// AddParam appends value to parameter name
+func (p *Property) AddParam(name, value string) {
+	if p.Params == nil {
+		p.Params = make(map[string][]string)
+	}
+	name = strings.ToUpper(name)
+	p.Params[name] = append(p.Params[name], value)
+}
+
+// Text returns unescaped value
+func (p Property) Text() string {
+	return UnescapeText(p.Value)
+}
+
+// Components returns unescaped components of structured value
+func (p Property) Components() []string {
+	return splitEscaped(p.Value, ';')
+}
+
+// Types returns lower-cased TYPE parameter values.
+// Comma-separated values ("TYPE=work,voice") are split
+func (p Property) Types() []string {
+	var res []string
+	for _, v := range p.Params["TYPE"] {
+		for _, t := range strings.Split(v, ",") {
+			if t = strings.ToLower(strings.TrimSpace(t)); t != "" {
+				res = append(res, t)
+			}
+		}
+	}
+	return res
+}
+
+// Preference returns property preference from 1 (most preferred) to 100.
+// Both PREF parameter of vCard 4.0 and TYPE=pref of vCard 3.0 are taken into account.
+// Properties without preference get 101
+func (p Property) Preference() int {
+	if v := p.Params["PREF"]; len(v) > 0 {
+		if pref, err := strconv.Atoi(v[0]); err == nil && pref >= 1 && pref <= 100 {
+			return pref
+		}
+	}
+	for _, t := range p.Types() {
+		if t == "pref" {
+			return 1
+		}
+	}
+	return 101
+}
+
+// EscapeText escapes backslashes, commas, semicolons and newlines of text value
+func EscapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// UnescapeText reverts EscapeText
+func UnescapeText(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	",", `\,`,
+	";", `\;`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// Splits s by unescaped sep and unescapes the parts
+func splitEscaped(s string, sep byte) []string {
+	var res []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case sep:
+			res = append(res, UnescapeText(s[start:i]))
+			start = i + 1
+		}
+	}
+	return append(res, UnescapeText(s[start:]))
+}
@@ -0,0 +1,132 @@
+package vcard
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestEncodeRoundTrip(t *testing.T) {
+	email := NewText("EMAIL", "ivan@example.com")
+	email.Group = "item1"
+	email.AddParam("TYPE", "work")
+	email.AddParam("PREF", "1")
+	tel := NewText("TEL", "tel:+79123456789")
+	tel.AddParam("VALUE", "uri")
+	tel.AddParam("TYPE", "cell")
+	label := NewText("X-LABEL", "a")
+	label.AddParam("X-NOTE", "one;two")
+
+	for _, version := range []string{Version30, Version40} {
+		t.Run(version, func(t *testing.T) {
+			card := Card{Version: version}
+			card.Add(NewText("FN", "Иван Петров, мл.; \\ tab\nnext"))
+			card.Add(NewStructured("N", "Петров", "Иван", "", "", "мл."))
+			card.Add(email)
+			card.Add(tel)
+			card.Add(NewList("CATEGORIES", "friends", "a,b", "c;d"))
+			card.Add(label)
+
+			var buf bytes.Buffer
+			if err := Encode(&buf, card); err != nil {
+				t.Fatal(err)
+			}
+			got, err := NewReader(&buf).Next()
+			if err != nil {
+				t.Fatalf("Next() error = %v\n%s", err, buf.String())
+			}
+			if !reflect.DeepEqual(got, card) {
+				t.Fatalf("Next() = %+v, want %+v", got, card)
+			}
+
+			fn, _ := got.Get("FN")
+			if fn.Text() != "Иван Петров, мл.; \\ tab\nnext" {
+				t.Errorf("FN = %q", fn.Text())
+			}
+			n, _ := got.Get("N")
+			if c := n.Components(); !reflect.DeepEqual(c, []string{"Петров", "Иван", "", "", "мл."}) {
+				t.Errorf("N = %q", c)
+			}
+			if _, err = NewReader(&buf).Next(); !errors.Is(err, io.EOF) {
+				t.Errorf("second Next() error = %v, want EOF", err)
+			}
+		})
+	}
+}
+
+func TestEncodeFoldsLongLines(t *testing.T) {
+	// Cyrillic letters take two octets, so a fold at 75 octets would split one of them
+	name := "a" + strings.Repeat("Щ", 200)
+	card := Card{Version: Version40}
+	card.Add(NewText("FN", name))
+	card.Add(NewText("NOTE", strings.Repeat("x", 300)))
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, card); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.String()
+	if !strings.HasSuffix(data, "\r\n") {
+		t.Fatalf("card does not end with CRLF: %q", data)
+	}
+	lines := strings.Split(strings.TrimSuffix(data, "\r\n"), "\r\n")
+	continued := 0
+	for _, line := range lines {
+		if len(line) > maxLineLength {
+			t.Errorf("line of %d octets: %q", len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("line splits a character: %q", line)
+		}
+		if strings.HasPrefix(line, " ") {
+			continued++
+		}
+	}
+	if continued == 0 {
+		t.Fatal("no folded lines")
+	}
+
+	got, err := NewReader(strings.NewReader(data)).Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fn, _ := got.Get("FN"); fn.Text() != name {
+		t.Errorf("FN = %q, want %q", fn.Text(), name)
+	}
+	if note, _ := got.Get("NOTE"); note.Text() != strings.Repeat("x", 300) {
+		t.Errorf("NOTE = %q", note.Text())
+	}
+}
+
+func TestReaderUnfoldsLines(t *testing.T) {
+	data := "BEGIN:VCARD\n" +
+		"VERSION:3.0\r\n" +
+		"FN:Ivan\r\n" +
+		"  Petrov\r\n" +
+		"EMAIL;TYPE=wo\r\n" +
+		"\trk,pref:ivan@exa\r\n" +
+		" mple.com\r\n" +
+		"END:VCARD\r\n"
+
+	card, err := NewReader(strings.NewReader(data)).Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fn, _ := card.Get("FN"); fn.Text() != "Ivan Petrov" {
+		t.Errorf("FN = %q, want %q", fn.Text(), "Ivan Petrov")
+	}
+	email, _ := card.Get("EMAIL")
+	if email.Text() != "ivan@example.com" {
+		t.Errorf("EMAIL = %q", email.Text())
+	}
+	if types := email.Types(); !reflect.DeepEqual(types, []string{"work", "pref"}) {
+		t.Errorf("EMAIL types = %q", types)
+	}
+	if email.Preference() != 1 {
+		t.Errorf("EMAIL preference = %d, want 1", email.Preference())
+	}
+}
@@ -0,0 +1,76 @@
+package vcard
+
+import (
+	"io"
+	"sort"
+	"strings"
+)
+
+// Lines are folded at 75 octets as recommended by RFC 6350
+const maxLineLength = 75
+
+// Encode writes card to w with CRLF line endings, folding long lines
+func Encode(w io.Writer, card Card) error {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCARD")
+	writeLine(&b, "VERSION:"+card.Version)
+	for _, p := range card.Properties {
+		writeLine(&b, formatProperty(p))
+	}
+	writeLine(&b, "END:VCARD")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func formatProperty(p Property) string {
+	var b strings.Builder
+	if p.Group != "" {
+		b.WriteString(p.Group)
+		b.WriteByte('.')
+	}
+	b.WriteString(p.Name)
+
+	params := make([]string, 0, len(p.Params))
+	for name := range p.Params {
+		params = append(params, name)
+	}
+	sort.Strings(params)
+	for _, name := range params {
+		b.WriteByte(';')
+		b.WriteString(name)
+		b.WriteByte('=')
+		for i, value := range p.Params[name] {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if strings.ContainsAny(value, ",;:") {
+				value = `"` + value + `"`
+			}
+			b.WriteString(value)
+		}
+	}
+
+	b.WriteByte(':')
+	b.WriteString(p.Value)
+	return b.String()
+}
+
+// Writes line folded into chunks of at most maxLineLength octets,
+// never splitting a multibyte character
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space
+		limit = maxLineLength - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
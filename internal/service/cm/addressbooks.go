@@ -0,0 +1,246 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/logger/sl"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"log/slog"
+)
+
+type AddressBookStorage interface {
+	CreateAddressBook(ctx context.Context, name, ownerEmail string) (int64, error)
+	AddressBooks(ctx context.Context, ownerKey string) ([]models.AddressBook, error)
+	AddressBook(ctx context.Context, ownerKey string, id int64) (models.AddressBook, error)
+	AnyAddressBook(ctx context.Context, id int64) (models.AddressBook, error)
+	AddressBookMembers(ctx context.Context, id int64) ([]models.AddressBookMember, error)
+	SaveAddressBookMember(ctx context.Context, id int64, email string, role models.BookRole) error
+	DeleteAddressBookMember(ctx context.Context, id int64, email string) error
+}
+
+var (
+	ErrAddressBookNotFound = errors.New("address book not found")
+	ErrMemberNotFound      = errors.New("address book member not found")
+	// ErrLastBookOwner means the change would leave a team address book without owners
+	ErrLastBookOwner = errors.New("last owner of address book")
+	// ErrPersonalAddressBook means members of a personal address book can't be changed
+	ErrPersonalAddressBook = errors.New("personal address book")
+)
+
+// AddressBookKey returns the key data of the address book is stored under,
+// which is passed as ownerKey to the other methods. The user must have
+// at least the needed role in the book. Zero bookID is the personal book of the user
+// with ownerKey, the key returned by RegisterUser
+func (cmg *ContactManager) AddressBookKey(
+	ctx context.Context,
+	ownerKey string,
+	bookID int64,
+	need models.BookRole,
+) (string, error) {
+	const op = "cm.AddressBookKey"
+
+	if bookID == 0 {
+		return ownerKey, nil
+	}
+
+	book, err := cmg.addressBook(ctx, ownerKey, bookID, need)
+	if err != nil {
+		// denials are logged where they happen
+		if !errors.Is(err, ErrAddressBookNotFound) && !errors.Is(err, ErrPermissionDenied) {
+			cmg.log.Error("failed to get address book", slog.String("op", op), sl.Err(err))
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return book.OwnerKey, nil
+}
+
+// AnyAddressBookKey returns the key data of any address book is stored under, without
+// checking membership. Only for users allowed to read data of every owner, so it is logged
+func (cmg *ContactManager) AnyAddressBookKey(
+	ctx context.Context,
+	bookID int64,
+) (string, error) {
+	const op = "cm.AnyAddressBookKey"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("book_id", bookID),
+	)
+
+	book, err := cmg.bookStorage.AnyAddressBook(ctx, bookID)
+	if err != nil {
+		if errors.Is(err, storage.ErrBookNotFound) {
+			return "", fmt.Errorf("%s: %w", op, ErrAddressBookNotFound)
+		}
+		log.Error("failed to get address book", sl.Err(err))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("reading address book of another owner", slog.String("owner_key", book.OwnerKey))
+	return book.OwnerKey, nil
+}
+
+// CreateAddressBook creates a team address book owned by the user
+func (cmg *ContactManager) CreateAddressBook(
+	ctx context.Context,
+	email, name string,
+) (int64, error) {
+	const op = "cm.CreateAddressBook"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("creating address book")
+
+	id, err := cmg.bookStorage.CreateAddressBook(ctx, name, email)
+	if err != nil {
+		log.Error("failed to create address book", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+// ListAddressBooks returns the user's personal book and team books the user is a member of
+func (cmg *ContactManager) ListAddressBooks(
+	ctx context.Context,
+	ownerKey string,
+) ([]models.AddressBook, error) {
+	const op = "cm.ListAddressBooks"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("listing address books")
+
+	books, err := cmg.bookStorage.AddressBooks(ctx, ownerKey)
+	if err != nil {
+		log.Error("failed to list address books", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return books, nil
+}
+
+// ListAddressBookMembers returns members of a book the user is a member of.
+// The only member of a personal book is its owner
+func (cmg *ContactManager) ListAddressBookMembers(
+	ctx context.Context,
+	ownerKey, email string,
+	bookID int64,
+) ([]models.AddressBookMember, error) {
+	const op = "cm.ListAddressBookMembers"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("address_book_id", bookID),
+	)
+	log.Info("listing address book members")
+
+	book, err := cmg.addressBook(ctx, ownerKey, bookID, models.RoleViewer)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if book.Personal {
+		return []models.AddressBookMember{{Email: email, Role: models.RoleOwner, CreatedAt: book.CreatedAt}}, nil
+	}
+
+	members, err := cmg.bookStorage.AddressBookMembers(ctx, bookID)
+	if err != nil {
+		log.Error("failed to list address book members", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return members, nil
+}
+
+// SetAddressBookMember adds a member to a team book or changes member's role.
+// Only owners of the book manage its members
+func (cmg *ContactManager) SetAddressBookMember(
+	ctx context.Context,
+	ownerKey string,
+	bookID int64,
+	memberEmail string,
+	role models.BookRole,
+) error {
+	const op = "cm.SetAddressBookMember"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("address_book_id", bookID),
+	)
+	log.Info("setting address book member")
+
+	book, err := cmg.addressBook(ctx, ownerKey, bookID, models.RoleOwner)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if book.Personal {
+		return fmt.Errorf("%s: %w", op, ErrPersonalAddressBook)
+	}
+
+	err = cmg.bookStorage.SaveAddressBookMember(ctx, bookID, memberEmail, role)
+	if err != nil {
+		if errors.Is(err, storage.ErrLastBookOwner) {
+			return fmt.Errorf("%s: %w", op, ErrLastBookOwner)
+		}
+		log.Error("failed to save address book member", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// RemoveAddressBookMember removes a member from a team book.
+// Owners remove any member, other members can only leave the book
+func (cmg *ContactManager) RemoveAddressBookMember(
+	ctx context.Context,
+	ownerKey, email string,
+	bookID int64,
+	memberEmail string,
+) error {
+	const op = "cm.RemoveAddressBookMember"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("address_book_id", bookID),
+	)
+	log.Info("removing address book member")
+
+	need := models.RoleOwner
+	if memberEmail == email {
+		need = models.RoleViewer
+	}
+	book, err := cmg.addressBook(ctx, ownerKey, bookID, need)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if book.Personal {
+		return fmt.Errorf("%s: %w", op, ErrPersonalAddressBook)
+	}
+
+	err = cmg.bookStorage.DeleteAddressBookMember(ctx, bookID, memberEmail)
+	if err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrMemberNotFound)
+		}
+		if errors.Is(err, storage.ErrLastBookOwner) {
+			return fmt.Errorf("%s: %w", op, ErrLastBookOwner)
+		}
+		log.Error("failed to delete address book member", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Returns the book if the user has at least the needed role in it.
+// Books the user isn't a member of are not found
+func (cmg *ContactManager) addressBook(
+	ctx context.Context,
+	ownerKey string,
+	bookID int64,
+	need models.BookRole,
+) (models.AddressBook, error) {
+	book, err := cmg.bookStorage.AddressBook(ctx, ownerKey, bookID)
+	if err != nil {
+		if errors.Is(err, storage.ErrBookNotFound) {
+			return models.AddressBook{}, ErrAddressBookNotFound
+		}
+		return models.AddressBook{}, err
+	}
+	if book.Role < need {
+		return models.AddressBook{}, cmg.deny(ctx, slog.Int64("book_id", bookID), int(book.Role), int(need))
+	}
+	return book, nil
+}
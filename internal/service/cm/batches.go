@@ -0,0 +1,133 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/logger/sl"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"log/slog"
+)
+
+type BatchStorage interface {
+	SaveContacts(ctx context.Context, contacts []models.Contact, author string, atomic bool) ([]int64, []error, error)
+	DeleteContacts(ctx context.Context, refs []models.ContactRef, author string, atomic bool) ([]error, error)
+}
+
+// ErrBatchAborted is the error of batch items not applied because another item failed
+var ErrBatchAborted = errors.New("batch aborted")
+
+// BatchCreateContacts creates contacts in one transaction and returns a result
+// for each of them. In atomic mode no contact is created if any of them fails
+func (cmg *ContactManager) BatchCreateContacts(
+	ctx context.Context,
+	ownerKey string,
+	contacts []models.Contact,
+	atomic bool,
+) ([]models.BatchResult, error) {
+	const op = "cm.BatchCreateContacts"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("creating contacts", slog.Int("count", len(contacts)), slog.Bool("atomic", atomic))
+
+	for i := range contacts {
+		contacts[i].OwnerKey = ownerKey
+	}
+	ids, errs, err := cmg.batchStorage.SaveContacts(ctx, contacts, authorFromContext(ctx, ownerKey), atomic)
+	if err != nil {
+		log.Error("failed to save contacts", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	results := make([]models.BatchResult, len(contacts))
+	for i, contact := range contacts {
+		if errs[i] != nil {
+			results[i].Err = cmg.batchItemError(log, errs[i])
+			continue
+		}
+		results[i].ID = ids[i]
+		cmg.publish(ctx, ownerKey, ids[i], models.ContactCreated, snapshot(contact))
+	}
+	return results, nil
+}
+
+// BatchDeleteContacts moves contacts to their owners' trash in one transaction and returns
+// a result for each of them. The user must own the contacts or have write access to them.
+// In atomic mode no contact is deleted if any of them fails
+func (cmg *ContactManager) BatchDeleteContacts(
+	ctx context.Context,
+	ownerKey string,
+	refs []models.ContactRef,
+	atomic bool,
+) ([]models.BatchResult, error) {
+	const op = "cm.BatchDeleteContacts"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("deleting contacts", slog.Int("count", len(refs)), slog.Bool("atomic", atomic))
+
+	results := make([]models.BatchResult, len(refs))
+	var allowed []int
+	for i := range refs {
+		results[i].ID = refs[i].ID
+		contact, err := cmg.accessContact(ctx, ownerKey, refs[i].ID, models.AccessWrite)
+		switch {
+		case err == nil:
+			refs[i].OwnerKey = contact.OwnerKey
+			allowed = append(allowed, i)
+		case errors.Is(err, storage.ErrContactNotFound):
+			results[i].Err = ErrContactNotFound
+		case errors.Is(err, ErrPermissionDenied):
+			results[i].Err = err
+		default:
+			log.Error("failed to get contact", sl.Err(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	if atomic && len(allowed) < len(refs) {
+		for _, i := range allowed {
+			results[i].Err = ErrBatchAborted
+		}
+		return results, nil
+	}
+	if len(allowed) == 0 {
+		return results, nil
+	}
+
+	batch := make([]models.ContactRef, len(allowed))
+	for j, i := range allowed {
+		batch[j] = refs[i]
+	}
+	errs, err := cmg.batchStorage.DeleteContacts(ctx, batch, authorFromContext(ctx, ownerKey), atomic)
+	if err != nil {
+		log.Error("failed to delete contacts", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for j, i := range allowed {
+		if errs[j] != nil {
+			results[i].Err = cmg.batchItemError(log, errs[j])
+			continue
+		}
+		cmg.publish(ctx, refs[i].OwnerKey, refs[i].ID, models.ContactDeleted, nil)
+	}
+	return results, nil
+}
+
+// Converts storage error of a batch item, unexpected errors are logged
+func (cmg *ContactManager) batchItemError(log *slog.Logger, err error) error {
+	switch {
+	case errors.Is(err, storage.ErrBatchAborted):
+		return ErrBatchAborted
+	case errors.Is(err, storage.ErrContactExists):
+		return contactExistsError(err)
+	case errors.Is(err, storage.ErrContactNotFound):
+		return ErrContactNotFound
+	case errors.Is(err, storage.ErrVersionMismatch):
+		return ErrVersionMismatch
+	}
+	log.Error("failed to apply batch item", sl.Err(err))
+	return err
+}
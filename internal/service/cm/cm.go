@@ -5,9 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/eventbus"
 	"gRPC_ContactManagement_Service/internal/lib/logger/sl"
+	"gRPC_ContactManagement_Service/internal/lib/similarity"
+	"gRPC_ContactManagement_Service/internal/lib/translit"
 	"gRPC_ContactManagement_Service/internal/storage"
 	"log/slog"
+	"sort"
+	"sync"
+	"time"
 )
 
 type ContactManager struct {
@@ -15,52 +21,159 @@ type ContactManager struct {
 	contactSaver    ContactSaver
 	contactProvider ContactProvider
 	contactDeleter  ContactDeleter
+	contactUpdater  ContactUpdater
+	groupStorage    GroupStorage
+	tagStorage      TagStorage
+	revisionStorage RevisionStorage
+	mergeStorage    MergeStorage
+	policyStorage   PolicyStorage
+	batchStorage    BatchStorage
+	syncStorage     SyncStorage
+	shareStorage    ShareStorage
+	bookStorage     AddressBookStorage
+	userStorage     UserStorage
+	// ids of users whose current email is already saved, mapped to the email
+	knownUsers     sync.Map
+	events         *eventbus.Bus[models.ContactEvent]
+	fuzzyThreshold float64
 }
 
 type ContactSaver interface {
 	SaveContact(
 		ctx context.Context,
-		creatorEmail, name, email, phone string,
+		contact models.Contact,
+		author string,
 	) (uid int64, err error)
 }
 
 type ContactProvider interface {
+	ContactById(
+		ctx context.Context,
+		id int64,
+	) (models.Contact, error)
+
 	Contact(
 		ctx context.Context,
-		creatorEmail, name, email, phone string,
+		ownerKey, name, email, phone string,
 	) (models.Contact, error)
+
+	Contacts(
+		ctx context.Context,
+		ownerKey string,
+		opts models.ListOptions,
+	) ([]models.Contact, error)
+
+	SearchContacts(
+		ctx context.Context,
+		ownerKey, query string,
+		filter models.ContactFilter,
+		limit int,
+	) ([]models.Contact, error)
 }
 
 type ContactDeleter interface {
 	DeleteContact(
 		ctx context.Context,
-		creatorEmail string,
-		id int64,
+		ownerKey string,
+		id, version int64,
+		author string,
+	) error
+
+	RestoreContact(
+		ctx context.Context,
+		ownerKey string,
+		id, version int64,
+		author string,
 	) error
+
+	PurgeContact(
+		ctx context.Context,
+		ownerKey string,
+		id, version int64,
+		author string,
+	) error
+
+	PurgeDeletedContacts(
+		ctx context.Context,
+		before time.Time,
+	) (int64, error)
+}
+
+type ContactUpdater interface {
+	UpdateContact(
+		ctx context.Context,
+		ownerKey string,
+		id, version int64,
+		upd models.ContactUpdate,
+		author string,
+	) (models.Contact, error)
+
+	RevertContact(
+		ctx context.Context,
+		ownerKey string,
+		id, version int64,
+		upd models.ContactUpdate,
+		author string,
+	) (models.Contact, error)
 }
 
 var (
-	ErrContactExists   = errors.New("contact exists")
-	ErrContactNotFound = errors.New("contact not found")
+	ErrContactExists    = errors.New("contact exists")
+	ErrContactNotFound  = errors.New("contact not found")
+	ErrInvalidPageToken = errors.New("invalid page token")
+	// ErrVersionMismatch means the contact was changed since the client read it
+	ErrVersionMismatch = errors.New("contact version mismatch")
 )
 
+// Storage is everything the service keeps in storage
+type Storage interface {
+	ContactSaver
+	ContactProvider
+	ContactDeleter
+	ContactUpdater
+	GroupStorage
+	TagStorage
+	RevisionStorage
+	MergeStorage
+	PolicyStorage
+	BatchStorage
+	SyncStorage
+	ShareStorage
+	AddressBookStorage
+	UserStorage
+}
+
 func New(
 	log *slog.Logger,
-	saver ContactSaver,
-	provider ContactProvider,
-	deleter ContactDeleter,
+	storage Storage,
+	events *eventbus.Bus[models.ContactEvent],
+	fuzzyThreshold float64,
 ) *ContactManager {
 	return &ContactManager{
 		log:             log,
-		contactSaver:    saver,
-		contactProvider: provider,
-		contactDeleter:  deleter,
+		contactSaver:    storage,
+		contactProvider: storage,
+		contactDeleter:  storage,
+		contactUpdater:  storage,
+		groupStorage:    storage,
+		tagStorage:      storage,
+		revisionStorage: storage,
+		mergeStorage:    storage,
+		policyStorage:   storage,
+		batchStorage:    storage,
+		syncStorage:     storage,
+		shareStorage:    storage,
+		bookStorage:     storage,
+		userStorage:     storage,
+		events:          events,
+		fuzzyThreshold:  fuzzyThreshold,
 	}
 }
 
 func (cmg *ContactManager) CreateContact(
 	ctx context.Context,
-	creatorEmail, name, email, phone string,
+	ownerKey string,
+	contact models.Contact,
 ) (int64, error) {
 	const op = "cm.CreateContact"
 	log := cmg.log.With(
@@ -68,21 +181,23 @@ func (cmg *ContactManager) CreateContact(
 	)
 	log.Info("creating contact")
 
-	uid, err := cmg.contactSaver.SaveContact(ctx, creatorEmail, name, email, phone)
+	contact.OwnerKey = ownerKey
+	uid, err := cmg.contactSaver.SaveContact(ctx, contact, authorFromContext(ctx, ownerKey))
 	if err != nil {
 		if errors.Is(err, storage.ErrContactExists) {
 			log.Warn("contact already exists", sl.Err(err))
-			return -1, fmt.Errorf("%s: %w", op, ErrContactExists)
+			return -1, fmt.Errorf("%s: %w", op, contactExistsError(err))
 		}
 		log.Error("failed to save contact", sl.Err(err))
 		return -1, fmt.Errorf("%s: %w", op, err)
 	}
+	cmg.publish(ctx, ownerKey, uid, models.ContactCreated, snapshot(contact))
 	return uid, nil
 }
 
 func (cmg *ContactManager) GetContactByName(
 	ctx context.Context,
-	creatorEmail, name string,
+	ownerKey, name string,
 ) (models.Contact, error) {
 	const op = "cm.GetContactByName"
 	log := cmg.log.With(
@@ -90,7 +205,7 @@ func (cmg *ContactManager) GetContactByName(
 	)
 	log.Info("searching for contact", slog.String("name", name))
 
-	contact, err := cmg.contactProvider.Contact(ctx, creatorEmail, name, "", "")
+	contact, err := cmg.contactProvider.Contact(ctx, ownerKey, name, "", "")
 	if err != nil {
 		if errors.Is(err, storage.ErrContactNotFound) {
 			return models.Contact{}, fmt.Errorf("%s: %w", op, ErrContactNotFound)
@@ -102,7 +217,7 @@ func (cmg *ContactManager) GetContactByName(
 
 func (cmg *ContactManager) GetContactByEmail(
 	ctx context.Context,
-	creatorEmail, email string,
+	ownerKey, email string,
 ) (models.Contact, error) {
 	const op = "cm.GetContactByEmail"
 	log := cmg.log.With(
@@ -110,7 +225,7 @@ func (cmg *ContactManager) GetContactByEmail(
 	)
 	log.Info("searching for contact", slog.String("email", email))
 
-	contact, err := cmg.contactProvider.Contact(ctx, creatorEmail, "", email, "")
+	contact, err := cmg.contactProvider.Contact(ctx, ownerKey, "", email, "")
 	if err != nil {
 		if errors.Is(err, storage.ErrContactNotFound) {
 			return models.Contact{}, fmt.Errorf("%s: %w", op, ErrContactNotFound)
@@ -122,7 +237,7 @@ func (cmg *ContactManager) GetContactByEmail(
 
 func (cmg *ContactManager) GetContactByPhone(
 	ctx context.Context,
-	creatorEmail, phone string,
+	ownerKey, phone string,
 ) (models.Contact, error) {
 	const op = "cm.GetContactByPhone"
 	log := cmg.log.With(
@@ -130,7 +245,7 @@ func (cmg *ContactManager) GetContactByPhone(
 	)
 	log.Info("searching for contact", slog.String("phone", phone))
 
-	contact, err := cmg.contactProvider.Contact(ctx, creatorEmail, "", "", phone)
+	contact, err := cmg.contactProvider.Contact(ctx, ownerKey, "", "", phone)
 	if err != nil {
 		if errors.Is(err, storage.ErrContactNotFound) {
 			return models.Contact{}, fmt.Errorf("%s: %w", op, ErrContactNotFound)
@@ -140,10 +255,13 @@ func (cmg *ContactManager) GetContactByPhone(
 	return contact, nil
 }
 
+// DeleteContact moves the contact to its owner's trash.
+// The user must own the contact or have write access to it.
+// Zero version deletes the contact regardless of its version
 func (cmg *ContactManager) DeleteContact(
 	ctx context.Context,
-	creatorEmail string,
-	id int64,
+	ownerKey string,
+	id, version int64,
 ) error {
 	const op = "cm.DeleteContact"
 	log := cmg.log.With(
@@ -151,12 +269,254 @@ func (cmg *ContactManager) DeleteContact(
 	)
 	log.Info("trying to delete contact")
 
-	err := cmg.contactDeleter.DeleteContact(ctx, creatorEmail, id)
+	current, err := cmg.accessContact(ctx, ownerKey, id, models.AccessWrite)
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return ErrContactNotFound
+		}
+		return err
+	}
+	contactOwner := current.OwnerKey
+
+	err = cmg.contactDeleter.DeleteContact(ctx, contactOwner, id, version, authorFromContext(ctx, ownerKey))
 	if err != nil {
 		if errors.Is(err, storage.ErrContactNotFound) {
 			return ErrContactNotFound
 		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return ErrVersionMismatch
+		}
+		log.Error("failed to delete contact", sl.Err(err))
 		return err
 	}
+	cmg.publish(ctx, contactOwner, id, models.ContactDeleted, nil)
 	return nil
 }
+
+// UpdateContact changes the contact if it still has the given version.
+// The user must own the contact or have write access to it.
+// Zero version updates the contact regardless of its version
+func (cmg *ContactManager) UpdateContact(
+	ctx context.Context,
+	ownerKey string,
+	id, version int64,
+	upd models.ContactUpdate,
+) (models.Contact, error) {
+	const op = "cm.UpdateContact"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("id", id),
+	)
+	log.Info("updating contact")
+
+	current, err := cmg.accessContact(ctx, ownerKey, id, models.AccessWrite)
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return models.Contact{}, fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		if errors.Is(err, ErrPermissionDenied) {
+			return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+		}
+		log.Error("failed to get contact", sl.Err(err))
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
+	contactOwner := current.OwnerKey
+
+	contact, err := cmg.contactUpdater.UpdateContact(ctx, contactOwner, id, version, upd, authorFromContext(ctx, ownerKey))
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return models.Contact{}, fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return models.Contact{}, fmt.Errorf("%s: %w", op, ErrVersionMismatch)
+		}
+		if errors.Is(err, storage.ErrContactExists) {
+			log.Warn("contact already exists", sl.Err(err))
+			return models.Contact{}, fmt.Errorf("%s: %w", op, contactExistsError(err))
+		}
+		log.Error("failed to update contact", sl.Err(err))
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
+	cmg.publish(ctx, contactOwner, id, models.ContactUpdated, snapshot(contact))
+	return contact, nil
+}
+
+// ListContacts returns a page of owner's contacts and the contacts shared with them
+// and the token of the next page. Only owner's own contacts are in trash.
+// Next page token is empty when there are no more contacts
+func (cmg *ContactManager) ListContacts(
+	ctx context.Context,
+	ownerKey string,
+	filter models.ContactFilter,
+	orderBy models.ContactOrder,
+	desc bool,
+	pageSize int,
+	pageToken string,
+) ([]models.Contact, string, error) {
+	const op = "cm.ListContacts"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("listing contacts")
+
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	filter.Shared = !filter.Deleted
+	opts := models.ListOptions{
+		Filter:  filter,
+		OrderBy: orderBy,
+		Desc:    desc,
+		// one extra contact tells whether there is a next page
+		Limit: pageSize + 1,
+	}
+	if pageToken != "" {
+		after, err := decodePageToken(pageToken, filter, orderBy, desc)
+		if err != nil {
+			log.Warn("invalid page token", sl.Err(err))
+			return nil, "", fmt.Errorf("%s: %w", op, err)
+		}
+		opts.After = after
+	}
+
+	contacts, err := cmg.contactProvider.Contacts(ctx, ownerKey, opts)
+	if err != nil {
+		log.Error("failed to list contacts", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	var nextPageToken string
+	if len(contacts) > pageSize {
+		contacts = contacts[:pageSize]
+		nextPageToken = encodePageToken(contacts[pageSize-1], filter, orderBy, desc)
+	}
+	return contacts, nextPageToken, nil
+}
+
+// SearchContacts does prefix search by name, email and phone over owner's
+// contacts and the contacts shared with them. Results are ranked by relevance
+func (cmg *ContactManager) SearchContacts(
+	ctx context.Context,
+	ownerKey, query string,
+	filter models.ContactFilter,
+	limit int,
+) ([]models.Contact, error) {
+	const op = "cm.SearchContacts"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("searching for contacts", slog.String("query", query))
+
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+
+	filter.Shared = !filter.Deleted
+	contacts, err := cmg.contactProvider.SearchContacts(ctx, ownerKey, query, filter, limit)
+	if err != nil {
+		log.Error("failed to search contacts", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return contacts, nil
+}
+
+// FuzzySearchContacts returns owner's and shared contacts whose names are similar to name
+// at least by the configured threshold, most similar first.
+// Names are compared by romanized keys, so typos in either script are tolerated
+func (cmg *ContactManager) FuzzySearchContacts(
+	ctx context.Context,
+	ownerKey, name string,
+	limit int,
+) ([]models.ContactMatch, error) {
+	const op = "cm.FuzzySearchContacts"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("fuzzy searching for contacts", slog.String("name", name))
+
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+
+	key := translit.Key(name)
+	var matches []models.ContactMatch
+	opts := models.ListOptions{
+		Filter:  models.ContactFilter{Shared: true},
+		OrderBy: models.OrderByID,
+		Limit:   MaxPageSize,
+	}
+	for {
+		contacts, err := cmg.contactProvider.Contacts(ctx, ownerKey, opts)
+		if err != nil {
+			log.Error("failed to list contacts", sl.Err(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, contact := range contacts {
+			contactKey := translit.Key(contact.Name)
+			score := max(similarity.Trigram(key, contactKey), similarity.Edit(key, contactKey))
+			if score >= cmg.fuzzyThreshold {
+				matches = append(matches, models.ContactMatch{Contact: contact, Score: score})
+			}
+		}
+
+		if len(contacts) < opts.Limit {
+			break
+		}
+		opts.After = &contacts[len(contacts)-1]
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches, nil
+}
+
+// ExportContacts passes all owner's contacts matching filter to yield, ordered by id.
+// Contacts are read page by page, so the whole address book is never held in memory.
+// Export stops at the first error returned by yield
+func (cmg *ContactManager) ExportContacts(
+	ctx context.Context,
+	ownerKey string,
+	filter models.ContactFilter,
+	yield func(models.Contact) error,
+) error {
+	const op = "cm.ExportContacts"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("exporting contacts")
+
+	opts := models.ListOptions{Filter: filter, OrderBy: models.OrderByID, Limit: MaxPageSize}
+	for {
+		contacts, err := cmg.contactProvider.Contacts(ctx, ownerKey, opts)
+		if err != nil {
+			log.Error("failed to list contacts", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, contact := range contacts {
+			if err = yield(contact); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		if len(contacts) < opts.Limit {
+			return nil
+		}
+		opts.After = &contacts[len(contacts)-1]
+	}
+}
@@ -0,0 +1,257 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/logger/sl"
+	"gRPC_ContactManagement_Service/internal/lib/similarity"
+	"gRPC_ContactManagement_Service/internal/lib/translit"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+type MergeStorage interface {
+	MergeContacts(ctx context.Context, ownerKey string, survivorID, version int64, mergedIDs []int64, author string) (int64, error)
+	Merge(ctx context.Context, ownerKey string, id int64) (models.Merge, error)
+	UndoMerge(ctx context.Context, ownerKey string, id int64, author string) (models.Merge, error)
+}
+
+var ErrMergeNotFound = errors.New("merge not found")
+
+// FindDuplicates clusters owner's contacts that are likely the same person:
+// contacts sharing an email (case-insensitive) or a phone, or having names
+// similar at least by the fuzzy search threshold. Clusters are ordered by the smallest contact id.
+// Every pair of names is compared, which is fine for the size of an address book
+func (cmg *ContactManager) FindDuplicates(
+	ctx context.Context,
+	ownerKey string,
+) ([]models.DuplicateCluster, error) {
+	const op = "cm.FindDuplicates"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("finding duplicates")
+
+	var contacts []models.Contact
+	err := cmg.ExportContacts(ctx, ownerKey, models.ContactFilter{}, func(contact models.Contact) error {
+		contacts = append(contacts, contact)
+		return nil
+	})
+	if err != nil {
+		log.Error("failed to list contacts", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	type link struct {
+		a, b   int
+		reason models.DuplicateReason
+	}
+	var links []link
+	linkShared := func(reason models.DuplicateReason, values func(models.Contact) []string) {
+		first := make(map[string]int)
+		for i, contact := range contacts {
+			for _, v := range values(contact) {
+				if j, ok := first[v]; ok && j != i {
+					links = append(links, link{j, i, reason})
+				} else if !ok {
+					first[v] = i
+				}
+			}
+		}
+	}
+	linkShared(models.DuplicateEmail, func(c models.Contact) []string {
+		var res []string
+		for _, e := range c.Emails {
+			res = append(res, strings.ToLower(strings.TrimSpace(e.Email)))
+		}
+		return res
+	})
+	linkShared(models.DuplicatePhone, func(c models.Contact) []string {
+		var res []string
+		for _, p := range c.Phones {
+			res = append(res, p.Phone)
+		}
+		return res
+	})
+
+	keys := make([]string, len(contacts))
+	for i, contact := range contacts {
+		keys[i] = translit.Key(contact.Name)
+	}
+	for i := range contacts {
+		for j := i + 1; j < len(contacts); j++ {
+			if keys[i] == "" || keys[j] == "" {
+				continue
+			}
+			score := max(similarity.Trigram(keys[i], keys[j]), similarity.Edit(keys[i], keys[j]))
+			if score >= cmg.fuzzyThreshold {
+				links = append(links, link{i, j, models.DuplicateName})
+			}
+		}
+	}
+
+	// Union-find over contact indexes
+	parent := make([]int, len(contacts))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for _, l := range links {
+		// The smaller index is the root, so roots keep the order of contacts
+		a, b := find(l.a), find(l.b)
+		parent[max(a, b)] = min(a, b)
+	}
+
+	reasons := make(map[int][]models.DuplicateReason)
+	for _, l := range links {
+		root := find(l.a)
+		if !slices.Contains(reasons[root], l.reason) {
+			reasons[root] = append(reasons[root], l.reason)
+		}
+	}
+
+	clusters := make(map[int]*models.DuplicateCluster)
+	var roots []int
+	for i, contact := range contacts {
+		root := find(i)
+		if _, ok := reasons[root]; !ok {
+			continue
+		}
+		cluster, ok := clusters[root]
+		if !ok {
+			cluster = &models.DuplicateCluster{Reasons: reasons[root]}
+			clusters[root] = cluster
+			roots = append(roots, root)
+		}
+		cluster.Contacts = append(cluster.Contacts, contact)
+	}
+
+	res := make([]models.DuplicateCluster, 0, len(roots))
+	for _, root := range roots {
+		res = append(res, *clusters[root])
+	}
+	return res, nil
+}
+
+// MergeContacts merges owner's contacts into the survivor and returns
+// the survivor and id of the merge. Merged contacts are moved to trash.
+// Only the owner merges contacts, as only the owner sees them in trash and undoes the merge,
+// write access to shared contacts is not enough.
+// Zero version merges regardless of the survivor's version
+func (cmg *ContactManager) MergeContacts(
+	ctx context.Context,
+	ownerKey string,
+	survivorID, version int64,
+	mergedIDs []int64,
+) (models.Contact, int64, error) {
+	const op = "cm.MergeContacts"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("id", survivorID),
+	)
+	log.Info("merging contacts")
+
+	for _, id := range append([]int64{survivorID}, mergedIDs...) {
+		contact, err := cmg.accessContact(ctx, ownerKey, id, models.AccessOwner)
+		// contacts in trash can't be merged, though the owner has access to them
+		if errors.Is(err, storage.ErrContactNotFound) || (err == nil && !contact.DeletedAt.IsZero()) {
+			return models.Contact{}, 0, fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		if err != nil {
+			return models.Contact{}, 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	mergeID, err := cmg.mergeStorage.MergeContacts(ctx, ownerKey, survivorID, version, mergedIDs, authorFromContext(ctx, ownerKey))
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return models.Contact{}, 0, fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return models.Contact{}, 0, fmt.Errorf("%s: %w", op, ErrVersionMismatch)
+		}
+		if errors.Is(err, storage.ErrContactExists) {
+			return models.Contact{}, 0, fmt.Errorf("%s: %w", op, contactExistsError(err))
+		}
+		log.Error("failed to merge contacts", sl.Err(err))
+		return models.Contact{}, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	survivor, err := cmg.ownContact(ctx, ownerKey, survivorID)
+	if err != nil {
+		log.Error("failed to get merged contact", sl.Err(err))
+		return models.Contact{}, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	cmg.publish(ctx, ownerKey, survivorID, models.ContactUpdated, snapshot(survivor))
+	for _, id := range mergedIDs {
+		cmg.publish(ctx, ownerKey, id, models.ContactDeleted, nil)
+	}
+	return survivor, mergeID, nil
+}
+
+// UndoMerge restores merged contacts from trash and returns the survivor
+// to its state before the merge. Merge can't be undone once the survivor
+// has been changed or merged contacts have left trash
+func (cmg *ContactManager) UndoMerge(
+	ctx context.Context,
+	ownerKey string,
+	mergeID int64,
+) (models.Contact, []int64, error) {
+	const op = "cm.UndoMerge"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("merge_id", mergeID),
+	)
+	log.Info("undoing merge")
+
+	merge, err := cmg.mergeStorage.Merge(ctx, ownerKey, mergeID)
+	if err != nil {
+		if errors.Is(err, storage.ErrMergeNotFound) {
+			return models.Contact{}, nil, fmt.Errorf("%s: %w", op, ErrMergeNotFound)
+		}
+		log.Error("failed to get merge", sl.Err(err))
+		return models.Contact{}, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err = cmg.mergeStorage.UndoMerge(ctx, ownerKey, mergeID, authorFromContext(ctx, ownerKey)); err != nil {
+		if errors.Is(err, storage.ErrMergeNotFound) {
+			return models.Contact{}, nil, fmt.Errorf("%s: %w", op, ErrMergeNotFound)
+		}
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return models.Contact{}, nil, fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return models.Contact{}, nil, fmt.Errorf("%s: %w", op, ErrVersionMismatch)
+		}
+		if errors.Is(err, storage.ErrContactExists) {
+			return models.Contact{}, nil, fmt.Errorf("%s: %w", op, contactExistsError(err))
+		}
+		log.Error("failed to undo merge", sl.Err(err))
+		return models.Contact{}, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	survivor, err := cmg.ownContact(ctx, ownerKey, merge.SurvivorID)
+	if err != nil {
+		log.Error("failed to get contact", sl.Err(err))
+		return models.Contact{}, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	cmg.publish(ctx, ownerKey, survivor.ID, models.ContactUpdated, snapshot(survivor))
+	for _, id := range merge.MergedIDs {
+		contact, err := cmg.ownContact(ctx, ownerKey, id)
+		if err != nil {
+			log.Error("failed to get restored contact", sl.Err(err))
+			continue
+		}
+		cmg.publish(ctx, ownerKey, id, models.ContactCreated, snapshot(contact))
+	}
+	return survivor, merge.MergedIDs, nil
+}
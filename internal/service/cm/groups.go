@@ -0,0 +1,257 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/logger/sl"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"log/slog"
+)
+
+type GroupStorage interface {
+	SaveGroup(ctx context.Context, ownerKey, name string) (int64, error)
+	RenameGroup(ctx context.Context, ownerKey string, id int64, name string) error
+	DeleteGroup(ctx context.Context, ownerKey string, id int64) error
+	Groups(ctx context.Context, ownerKey string) ([]models.Group, error)
+	AddGroupMembers(ctx context.Context, ownerKey string, groupID int64, contactIDs []int64) error
+	RemoveGroupMembers(ctx context.Context, ownerKey string, groupID int64, contactIDs []int64) error
+}
+
+type TagStorage interface {
+	AddContactTags(ctx context.Context, ownerKey string, contactID, version int64, tags []string) error
+	RemoveContactTags(ctx context.Context, ownerKey string, contactID, version int64, tags []string) error
+}
+
+var (
+	ErrGroupExists   = errors.New("group exists")
+	ErrGroupNotFound = errors.New("group not found")
+)
+
+func (cmg *ContactManager) CreateGroup(
+	ctx context.Context,
+	ownerKey, name string,
+) (int64, error) {
+	const op = "cm.CreateGroup"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("creating group", slog.String("name", name))
+
+	id, err := cmg.groupStorage.SaveGroup(ctx, ownerKey, name)
+	if err != nil {
+		if errors.Is(err, storage.ErrGroupExists) {
+			log.Warn("group already exists", sl.Err(err))
+			return -1, fmt.Errorf("%s: %w", op, ErrGroupExists)
+		}
+		log.Error("failed to save group", sl.Err(err))
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (cmg *ContactManager) RenameGroup(
+	ctx context.Context,
+	ownerKey string,
+	id int64,
+	name string,
+) error {
+	const op = "cm.RenameGroup"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("id", id),
+	)
+	log.Info("renaming group", slog.String("name", name))
+
+	err := cmg.groupStorage.RenameGroup(ctx, ownerKey, id, name)
+	if err != nil {
+		if errors.Is(err, storage.ErrGroupNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		}
+		if errors.Is(err, storage.ErrGroupExists) {
+			log.Warn("group already exists", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, ErrGroupExists)
+		}
+		log.Error("failed to rename group", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// DeleteGroup deletes the group. Its members are not deleted
+func (cmg *ContactManager) DeleteGroup(
+	ctx context.Context,
+	ownerKey string,
+	id int64,
+) error {
+	const op = "cm.DeleteGroup"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("id", id),
+	)
+	log.Info("deleting group")
+
+	err := cmg.groupStorage.DeleteGroup(ctx, ownerKey, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrGroupNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		}
+		log.Error("failed to delete group", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (cmg *ContactManager) ListGroups(
+	ctx context.Context,
+	ownerKey string,
+) ([]models.Group, error) {
+	const op = "cm.ListGroups"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("listing groups")
+
+	groups, err := cmg.groupStorage.Groups(ctx, ownerKey)
+	if err != nil {
+		log.Error("failed to list groups", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return groups, nil
+}
+
+func (cmg *ContactManager) AddGroupMembers(
+	ctx context.Context,
+	ownerKey string,
+	groupID int64,
+	contactIDs []int64,
+) error {
+	const op = "cm.AddGroupMembers"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("group_id", groupID),
+	)
+	log.Info("adding group members")
+
+	err := cmg.groupStorage.AddGroupMembers(ctx, ownerKey, groupID, contactIDs)
+	if err != nil {
+		if errors.Is(err, storage.ErrGroupNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		}
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		log.Error("failed to add group members", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (cmg *ContactManager) RemoveGroupMembers(
+	ctx context.Context,
+	ownerKey string,
+	groupID int64,
+	contactIDs []int64,
+) error {
+	const op = "cm.RemoveGroupMembers"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("group_id", groupID),
+	)
+	log.Info("removing group members")
+
+	err := cmg.groupStorage.RemoveGroupMembers(ctx, ownerKey, groupID, contactIDs)
+	if err != nil {
+		if errors.Is(err, storage.ErrGroupNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		}
+		log.Error("failed to remove group members", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// AddContactTags tags the contact. The user must own the contact or have write access to it,
+// tags are those of the contact owner
+func (cmg *ContactManager) AddContactTags(
+	ctx context.Context,
+	ownerKey string,
+	contactID, version int64,
+	tags []string,
+) error {
+	const op = "cm.AddContactTags"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("id", contactID),
+	)
+	log.Info("tagging contact")
+
+	contactOwner, err := cmg.contactOwnerKey(ctx, ownerKey, contactID, models.AccessWrite)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = cmg.tagStorage.AddContactTags(ctx, contactOwner, contactID, version, tags)
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return fmt.Errorf("%s: %w", op, ErrVersionMismatch)
+		}
+		log.Error("failed to tag contact", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	cmg.publishTagsChange(ctx, log, contactOwner, contactID)
+	return nil
+}
+
+// RemoveContactTags untags the contact. The user must own the contact or have write access to it,
+// tags are those of the contact owner
+func (cmg *ContactManager) RemoveContactTags(
+	ctx context.Context,
+	ownerKey string,
+	contactID, version int64,
+	tags []string,
+) error {
+	const op = "cm.RemoveContactTags"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("id", contactID),
+	)
+	log.Info("untagging contact")
+
+	contactOwner, err := cmg.contactOwnerKey(ctx, ownerKey, contactID, models.AccessWrite)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = cmg.tagStorage.RemoveContactTags(ctx, contactOwner, contactID, version, tags)
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return fmt.Errorf("%s: %w", op, ErrVersionMismatch)
+		}
+		log.Error("failed to untag contact", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	cmg.publishTagsChange(ctx, log, contactOwner, contactID)
+	return nil
+}
+
+// Tags are not part of revisions, but their change bumps the contact version,
+// so watchers are told the contact is updated
+func (cmg *ContactManager) publishTagsChange(ctx context.Context, log *slog.Logger, ownerKey string, contactID int64) {
+	var after *models.ContactSnapshot
+	contact, err := cmg.contactProvider.ContactById(ctx, contactID)
+	if err != nil {
+		// watchers still learn about the change and can read the contact
+		log.Error("failed to read tagged contact", sl.Err(err))
+	} else {
+		after = snapshot(contact)
+	}
+	cmg.publish(ctx, ownerKey, contactID, models.ContactUpdated, after)
+}
@@ -0,0 +1,87 @@
+package cm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"time"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+
+	DefaultSyncPageSize = 500
+	MaxSyncPageSize     = 1000
+)
+
+// pageToken is a cursor of ListContacts. It is passed to clients
+// as an opaque base64 string and points to the last contact of a page.
+// The filter and sort order of the page are kept to reject the token for other lists
+type pageToken struct {
+	OrderBy   models.ContactOrder `json:"o"`
+	Desc      bool                `json:"d"`
+	GroupID   int64               `json:"g,omitempty"`
+	Tag       string              `json:"t,omitempty"`
+	Deleted   bool                `json:"del,omitempty"`
+	ID        int64               `json:"id"`
+	Name      string              `json:"n,omitempty"`
+	Email     string              `json:"e,omitempty"`
+	CreatedAt int64               `json:"c,omitempty"`
+	DeletedAt int64               `json:"da,omitempty"`
+}
+
+func encodePageToken(last models.Contact, filter models.ContactFilter, orderBy models.ContactOrder, desc bool) string {
+	token := pageToken{
+		OrderBy: orderBy,
+		Desc:    desc,
+		GroupID: filter.GroupID,
+		Tag:     filter.Tag,
+		Deleted: filter.Deleted,
+		ID:      last.ID,
+	}
+	switch orderBy {
+	case models.OrderByName:
+		token.Name = last.Name
+	case models.OrderByEmail:
+		token.Email = last.Email
+	case models.OrderByCreatedAt:
+		token.CreatedAt = last.CreatedAt.Unix()
+	case models.OrderByDeletedAt:
+		token.DeletedAt = last.DeletedAt.Unix()
+	}
+
+	raw, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Decodes page token into the last contact of previous page.
+// Token must be issued for the same filter and sort order
+func decodePageToken(s string, filter models.ContactFilter, orderBy models.ContactOrder, desc bool) (*models.Contact, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var token pageToken
+	if err = json.Unmarshal(raw, &token); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if token.OrderBy != orderBy || token.Desc != desc {
+		return nil, ErrInvalidPageToken
+	}
+	if token.GroupID != filter.GroupID || token.Tag != filter.Tag || token.Deleted != filter.Deleted {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &models.Contact{
+		ID:        token.ID,
+		Name:      token.Name,
+		Email:     token.Email,
+		CreatedAt: time.Unix(token.CreatedAt, 0),
+		DeletedAt: time.Unix(token.DeletedAt, 0),
+	}, nil
+}
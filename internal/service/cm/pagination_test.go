@@ -0,0 +1,52 @@
+package cm
+
+import (
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"testing"
+	"time"
+)
+
+func TestPageTokenKeepsFilterAndOrder(t *testing.T) {
+	last := models.Contact{ID: 7, Name: "Ivan", DeletedAt: time.Unix(100, 0)}
+	filter := models.ContactFilter{GroupID: 3, Tag: "friends"}
+	token := encodePageToken(last, filter, models.OrderByName, false)
+
+	after, err := decodePageToken(token, filter, models.OrderByName, false)
+	if err != nil {
+		t.Fatalf("decodePageToken() error = %v", err)
+	}
+	if after.ID != last.ID || after.Name != last.Name {
+		t.Errorf("decodePageToken() = %+v, want %+v", after, last)
+	}
+
+	deleted := models.ContactFilter{Deleted: true}
+	trashToken := encodePageToken(last, deleted, models.OrderByDeletedAt, true)
+	if _, err = decodePageToken(trashToken, deleted, models.OrderByDeletedAt, true); err != nil {
+		t.Fatalf("trash token: error = %v", err)
+	}
+
+	mismatches := []struct {
+		name    string
+		token   string
+		filter  models.ContactFilter
+		orderBy models.ContactOrder
+		desc    bool
+	}{
+		{name: "other group", token: token, filter: models.ContactFilter{GroupID: 4, Tag: "friends"}, orderBy: models.OrderByName},
+		{name: "other tag", token: token, filter: models.ContactFilter{GroupID: 3}, orderBy: models.OrderByName},
+		{name: "no filter", token: token, orderBy: models.OrderByName},
+		{name: "other order", token: token, filter: filter, orderBy: models.OrderByEmail},
+		{name: "other direction", token: token, filter: filter, orderBy: models.OrderByName, desc: true},
+		{name: "trash token in list", token: trashToken, orderBy: models.OrderByDeletedAt, desc: true},
+		{name: "list token in trash", token: encodePageToken(last, models.ContactFilter{}, models.OrderByDeletedAt, true), filter: deleted, orderBy: models.OrderByDeletedAt, desc: true},
+		{name: "garbage", token: "not a token", filter: filter, orderBy: models.OrderByName},
+	}
+	for _, tt := range mismatches {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodePageToken(tt.token, tt.filter, tt.orderBy, tt.desc); !errors.Is(err, ErrInvalidPageToken) {
+				t.Errorf("decodePageToken() error = %v, want %v", err, ErrInvalidPageToken)
+			}
+		})
+	}
+}
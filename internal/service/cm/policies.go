@@ -0,0 +1,72 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/logger/sl"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"log/slog"
+)
+
+type PolicyStorage interface {
+	UniquenessPolicy(ctx context.Context, ownerKey string) (models.UniquenessPolicy, error)
+	SetUniquenessPolicy(ctx context.Context, ownerKey string, policy models.UniquenessPolicy) error
+}
+
+// Converts storage error about existing contact keeping *models.ContactExistsError
+func contactExistsError(err error) error {
+	var existsErr *models.ContactExistsError
+	if errors.As(err, &existsErr) {
+		return fmt.Errorf("%w: %w", ErrContactExists, existsErr)
+	}
+	return ErrContactExists
+}
+
+func (cmg *ContactManager) GetUniquenessPolicy(
+	ctx context.Context,
+	ownerKey string,
+) (models.UniquenessPolicy, error) {
+	const op = "cm.GetUniquenessPolicy"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("getting uniqueness policy")
+
+	policy, err := cmg.policyStorage.UniquenessPolicy(ctx, ownerKey)
+	if err != nil {
+		log.Error("failed to get uniqueness policy", sl.Err(err))
+		return models.UniquenessPolicy{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return policy, nil
+}
+
+// SetUniquenessPolicy changes owner's policy. It fails with *models.ContactExistsError
+// if owner's contacts already violate the new policy
+func (cmg *ContactManager) SetUniquenessPolicy(
+	ctx context.Context,
+	ownerKey string,
+	policy models.UniquenessPolicy,
+) error {
+	const op = "cm.SetUniquenessPolicy"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info(
+		"setting uniqueness policy",
+		slog.Bool("unique_email", policy.UniqueEmail),
+		slog.Bool("unique_phone", policy.UniquePhone),
+	)
+
+	err := cmg.policyStorage.SetUniquenessPolicy(ctx, ownerKey, policy)
+	if err != nil {
+		if errors.Is(err, storage.ErrContactExists) {
+			log.Warn("contacts violate uniqueness policy", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, contactExistsError(err))
+		}
+		log.Error("failed to set uniqueness policy", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"gRPC_ContactManagement_Service/internal/lib/logger/sl"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"log/slog"
+	"time"
+)
+
+type RevisionStorage interface {
+	Revisions(ctx context.Context, ownerKey string, contactID int64) ([]models.Revision, error)
+	Revision(ctx context.Context, ownerKey string, contactID, id int64) (models.Revision, error)
+}
+
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// ListContactRevisions returns the history of the contact, the latest revision first.
+// History of contacts the user owns is available even after they are purged,
+// history of shared contacts only while the user has access to them
+func (cmg *ContactManager) ListContactRevisions(
+	ctx context.Context,
+	ownerKey string,
+	contactID int64,
+) ([]models.Revision, error) {
+	const op = "cm.ListContactRevisions"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("id", contactID),
+	)
+	log.Info("listing contact revisions")
+
+	contactOwner := ownerKey
+	contact, err := cmg.accessContact(ctx, ownerKey, contactID, models.AccessRead)
+	if err == nil {
+		contactOwner = contact.OwnerKey
+	} else if !errors.Is(err, storage.ErrContactNotFound) {
+		log.Error("failed to get contact", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	revisions, err := cmg.revisionStorage.Revisions(ctx, contactOwner, contactID)
+	if err != nil {
+		log.Error("failed to list revisions", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return revisions, nil
+}
+
+// RestoreContactRevision returns the contact to the state it had after the revision.
+// The user must own the contact or have write access to it.
+// For delete revisions the state right before deletion is restored.
+// Contact in trash is restored from trash first, purged contacts are not found.
+// Zero version restores the revision regardless of the contact's version
+func (cmg *ContactManager) RestoreContactRevision(
+	ctx context.Context,
+	ownerKey string,
+	contactID, revisionID, version int64,
+) (models.Contact, error) {
+	const op = "cm.RestoreContactRevision"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("id", contactID),
+		slog.Int64("revision_id", revisionID),
+	)
+	log.Info("restoring contact revision")
+
+	// Watchers of a contact restored from trash are told it is back
+	current, err := cmg.accessContact(ctx, ownerKey, contactID, models.AccessWrite)
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return models.Contact{}, fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		if !errors.Is(err, ErrPermissionDenied) {
+			log.Error("failed to get contact", sl.Err(err))
+		}
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
+	contactOwner := current.OwnerKey
+
+	rev, err := cmg.revisionStorage.Revision(ctx, contactOwner, contactID, revisionID)
+	if err != nil {
+		if errors.Is(err, storage.ErrRevisionNotFound) {
+			return models.Contact{}, fmt.Errorf("%s: %w", op, ErrRevisionNotFound)
+		}
+		log.Error("failed to get revision", sl.Err(err))
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
+	state := rev.After
+	if state == nil {
+		state = rev.Before
+	}
+
+	upd := models.ContactUpdate{
+		Name:   &state.Name,
+		Email:  new(string),
+		Phone:  new(string),
+		Emails: append([]models.ContactEmail{}, state.Emails...),
+		Phones: append([]models.ContactPhone{}, state.Phones...),
+	}
+	for _, e := range state.Emails {
+		if e.Primary {
+			*upd.Email = e.Email
+		}
+	}
+	for _, p := range state.Phones {
+		if p.Primary {
+			*upd.Phone = p.Phone
+		}
+	}
+
+	contact, err := cmg.contactUpdater.RevertContact(ctx, contactOwner, contactID, version, upd, authorFromContext(ctx, ownerKey))
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return models.Contact{}, fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return models.Contact{}, fmt.Errorf("%s: %w", op, ErrVersionMismatch)
+		}
+		if errors.Is(err, storage.ErrContactExists) {
+			return models.Contact{}, fmt.Errorf("%s: %w", op, contactExistsError(err))
+		}
+		log.Error("failed to revert contact", sl.Err(err))
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
+	eventType := models.ContactUpdated
+	if !current.DeletedAt.IsZero() {
+		eventType = models.ContactCreated
+	}
+	cmg.publish(ctx, contactOwner, contactID, eventType, snapshot(contact))
+	return contact, nil
+}
+
+// Returns owner's contact by id including contacts in trash
+func (cmg *ContactManager) ownContact(
+	ctx context.Context,
+	ownerKey string,
+	id int64,
+) (models.Contact, error) {
+	contact, err := cmg.contactProvider.ContactById(ctx, id)
+	if err != nil {
+		return models.Contact{}, err
+	}
+	if contact.OwnerKey != ownerKey {
+		return models.Contact{}, storage.ErrContactNotFound
+	}
+	return contact, nil
+}
+
+// Publishes a change of owner's contact to watchers of the owner's book and of the users
+// the contact is shared with, contact is the state after the change
+func (cmg *ContactManager) publish(
+	ctx context.Context,
+	ownerKey string,
+	contactID int64,
+	eventType models.ContactEventType,
+	contact *models.ContactSnapshot,
+) {
+	event := models.ContactEvent{
+		Type:      eventType,
+		ContactID: contactID,
+		Contact:   contact,
+		CreatedAt: time.Now(),
+	}
+	cmg.events.Publish(ownerKey, event)
+
+	grantees, err := cmg.shareStorage.ContactGrantees(ctx, contactID)
+	if err != nil {
+		cmg.log.Error("failed to get grantees of contact", slog.Int64("id", contactID), sl.Err(err))
+		return
+	}
+	for _, key := range grantees {
+		cmg.events.Publish(key, event)
+	}
+}
+
+// Changes are attributed to the email of the user who made the request,
+// rather than to the owner key of the contact
+func authorFromContext(ctx context.Context, fallback string) string {
+	if user, ok := auth.FromContext(ctx); ok {
+		return user.Email
+	}
+	return fallback
+}
+
+func snapshot(contact models.Contact) *models.ContactSnapshot {
+	return &models.ContactSnapshot{
+		Name:   contact.Name,
+		Emails: contact.Emails,
+		Phones: contact.Phones,
+	}
+}
@@ -0,0 +1,186 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"gRPC_ContactManagement_Service/internal/lib/logger/sl"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"google.golang.org/grpc"
+	"log/slog"
+)
+
+type ShareStorage interface {
+	SaveShare(ctx context.Context, share models.Share) (models.Share, error)
+	DeleteShare(ctx context.Context, ownerKey string, id int64) error
+	Shares(ctx context.Context, ownerKey string) ([]models.Share, error)
+	ContactAccess(ctx context.Context, ownerKey string, contactID int64) (models.Contact, models.Access, error)
+	ContactGrantees(ctx context.Context, contactID int64) ([]string, error)
+}
+
+var (
+	ErrShareNotFound = errors.New("share not found")
+	// ErrPermissionDenied means the contact is shared with the user
+	// but the user's access doesn't allow the operation
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// ShareContact grants the grantee read or write access to owner's contact.
+// Sharing the contact with the grantee again changes the access
+func (cmg *ContactManager) ShareContact(
+	ctx context.Context,
+	ownerKey string,
+	contactID int64,
+	granteeEmail string,
+	access models.Access,
+) (models.Share, error) {
+	const op = "cm.ShareContact"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("id", contactID),
+	)
+	log.Info("sharing contact")
+
+	share, err := cmg.shareStorage.SaveShare(ctx, models.Share{
+		OwnerKey:     ownerKey,
+		ContactID:    contactID,
+		GranteeEmail: granteeEmail,
+		Access:       access,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return models.Share{}, fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		log.Error("failed to share contact", sl.Err(err))
+		return models.Share{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return share, nil
+}
+
+// ShareGroup grants the grantee read or write access to all contacts
+// of owner's group, including the ones added to the group later
+func (cmg *ContactManager) ShareGroup(
+	ctx context.Context,
+	ownerKey string,
+	groupID int64,
+	granteeEmail string,
+	access models.Access,
+) (models.Share, error) {
+	const op = "cm.ShareGroup"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("group_id", groupID),
+	)
+	log.Info("sharing group")
+
+	share, err := cmg.shareStorage.SaveShare(ctx, models.Share{
+		OwnerKey:     ownerKey,
+		GroupID:      groupID,
+		GranteeEmail: granteeEmail,
+		Access:       access,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrGroupNotFound) {
+			return models.Share{}, fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		}
+		log.Error("failed to share group", sl.Err(err))
+		return models.Share{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return share, nil
+}
+
+func (cmg *ContactManager) RemoveShare(
+	ctx context.Context,
+	ownerKey string,
+	id int64,
+) error {
+	const op = "cm.RemoveShare"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("id", id),
+	)
+	log.Info("removing share")
+
+	if err := cmg.shareStorage.DeleteShare(ctx, ownerKey, id); err != nil {
+		if errors.Is(err, storage.ErrShareNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrShareNotFound)
+		}
+		log.Error("failed to remove share", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ListShares returns access the owner has granted to other users
+func (cmg *ContactManager) ListShares(
+	ctx context.Context,
+	ownerKey string,
+) ([]models.Share, error) {
+	const op = "cm.ListShares"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("listing shares")
+
+	shares, err := cmg.shareStorage.Shares(ctx, ownerKey)
+	if err != nil {
+		log.Error("failed to list shares", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return shares, nil
+}
+
+// Returns the contact if the user has at least the needed access to it.
+// Contacts the user can't see at all are not found, so their ids don't leak
+func (cmg *ContactManager) accessContact(
+	ctx context.Context,
+	ownerKey string,
+	id int64,
+	need models.Access,
+) (models.Contact, error) {
+	contact, access, err := cmg.shareStorage.ContactAccess(ctx, ownerKey, id)
+	if err != nil {
+		return models.Contact{}, err
+	}
+	if access < need {
+		return models.Contact{}, cmg.deny(ctx, slog.Int64("contact_id", id), int(access), int(need))
+	}
+	return contact, nil
+}
+
+// Logs that the user lacks access to the book or contact and returns ErrPermissionDenied.
+// Every denial of the service goes through it
+func (cmg *ContactManager) deny(ctx context.Context, target slog.Attr, has, need int) error {
+	log := cmg.log.With(
+		target,
+		slog.Int("has", has),
+		slog.Int("need", need),
+	)
+	if method, ok := grpc.Method(ctx); ok {
+		log = log.With(slog.String("method", method))
+	}
+	if user, ok := auth.FromContext(ctx); ok {
+		log = log.With(slog.Int64("user_id", user.UserID))
+	}
+	log.Warn("permission denied")
+	return ErrPermissionDenied
+}
+
+// Returns the key the contact is stored under if the user has at least the needed access to it
+func (cmg *ContactManager) contactOwnerKey(
+	ctx context.Context,
+	ownerKey string,
+	id int64,
+	need models.Access,
+) (string, error) {
+	contact, err := cmg.accessContact(ctx, ownerKey, id, need)
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return "", ErrContactNotFound
+		}
+		return "", err
+	}
+	return contact.OwnerKey, nil
+}
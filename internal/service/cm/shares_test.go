@@ -0,0 +1,150 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/logger/handlers/slogdiscard"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"testing"
+	"time"
+)
+
+const (
+	owner   = "user:1"
+	grantee = "user:2"
+)
+
+// Contacts of owner
+const (
+	notSharedID = 10 + iota
+	readID
+	writeID
+	otherWriteID
+	// in trash, shared for writing before it was deleted
+	trashedID
+)
+
+// Access of grantee to owner's contacts
+var grantedAccess = map[int64]models.Access{
+	readID:       models.AccessRead,
+	writeID:      models.AccessWrite,
+	otherWriteID: models.AccessWrite,
+	trashedID:    models.AccessWrite,
+}
+
+// Storage of owner's contacts shared with grantee by grantedAccess, other methods panic
+type fakeShares struct {
+	Storage
+}
+
+func (fakeShares) ContactAccess(_ context.Context, ownerKey string, id int64) (models.Contact, models.Access, error) {
+	contact := models.Contact{ID: id, OwnerKey: owner}
+	if id == trashedID {
+		if ownerKey != owner {
+			return models.Contact{}, models.AccessNone, storage.ErrContactNotFound
+		}
+		contact.DeletedAt = time.Unix(1, 0)
+	}
+	if ownerKey == owner {
+		return contact, models.AccessOwner, nil
+	}
+	access := grantedAccess[id]
+	if access == models.AccessNone {
+		return models.Contact{}, models.AccessNone, storage.ErrContactNotFound
+	}
+	return contact, access, nil
+}
+
+// Other methods of the storage panic, so a write that gets past the access check fails the test
+func newSharedManager() *ContactManager {
+	return New(slogdiscard.NewDiscardLogger(), fakeShares{}, nil, 0)
+}
+
+func TestWritesToSharedContactsNeedAccess(t *testing.T) {
+	cmg := newSharedManager()
+	ctx := context.Background()
+	name := "x"
+
+	writes := []struct {
+		name  string
+		write func(id int64) error
+	}{
+		{name: "delete", write: func(id int64) error {
+			return cmg.DeleteContact(ctx, grantee, id, 0)
+		}},
+		{name: "update", write: func(id int64) error {
+			_, err := cmg.UpdateContact(ctx, grantee, id, 0, models.ContactUpdate{Name: &name})
+			return err
+		}},
+		{name: "add tags", write: func(id int64) error {
+			return cmg.AddContactTags(ctx, grantee, id, 0, []string{"a"})
+		}},
+		{name: "remove tags", write: func(id int64) error {
+			return cmg.RemoveContactTags(ctx, grantee, id, 0, []string{"a"})
+		}},
+		{name: "restore", write: func(id int64) error {
+			return cmg.RestoreContact(ctx, grantee, id, 0)
+		}},
+		{name: "purge", write: func(id int64) error {
+			return cmg.PurgeContact(ctx, grantee, id, 0)
+		}},
+		{name: "restore revision", write: func(id int64) error {
+			_, err := cmg.RestoreContactRevision(ctx, grantee, id, 1, 0)
+			return err
+		}},
+	}
+	for _, w := range writes {
+		t.Run(w.name, func(t *testing.T) {
+			if err := w.write(notSharedID); !errors.Is(err, ErrContactNotFound) {
+				t.Errorf("not shared: error = %v, want %v", err, ErrContactNotFound)
+			}
+			if err := w.write(readID); !errors.Is(err, ErrPermissionDenied) {
+				t.Errorf("shared read-only: error = %v, want %v", err, ErrPermissionDenied)
+			}
+		})
+	}
+}
+
+func TestMergeNeedsOwner(t *testing.T) {
+	cmg := newSharedManager()
+	ctx := context.Background()
+
+	// grantee can write to both contacts, but only owner merges them
+	_, _, err := cmg.MergeContacts(ctx, grantee, writeID, 0, []int64{otherWriteID})
+	if !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("error = %v, want %v", err, ErrPermissionDenied)
+	}
+}
+
+func TestMergeSkipsTrash(t *testing.T) {
+	cmg := newSharedManager()
+	ctx := context.Background()
+
+	if _, _, err := cmg.MergeContacts(ctx, owner, writeID, 0, []int64{trashedID}); !errors.Is(err, ErrContactNotFound) {
+		t.Errorf("merged in trash: error = %v, want %v", err, ErrContactNotFound)
+	}
+	if _, _, err := cmg.MergeContacts(ctx, owner, trashedID, 0, []int64{writeID}); !errors.Is(err, ErrContactNotFound) {
+		t.Errorf("survivor in trash: error = %v, want %v", err, ErrContactNotFound)
+	}
+}
+
+func TestBatchDeleteChecksAccessOfEachContact(t *testing.T) {
+	cmg := newSharedManager()
+	refs := []models.ContactRef{
+		{ID: writeID},
+		{ID: readID},
+		{ID: notSharedID},
+	}
+
+	results, err := cmg.BatchDeleteContacts(context.Background(), grantee, refs, true)
+	if err != nil {
+		t.Fatalf("BatchDeleteContacts() error = %v", err)
+	}
+	want := []error{ErrBatchAborted, ErrPermissionDenied, ErrContactNotFound}
+	for i, res := range results {
+		if !errors.Is(res.Err, want[i]) {
+			t.Errorf("result %d error = %v, want %v", i, res.Err, want[i])
+		}
+	}
+}
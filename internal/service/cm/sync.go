@@ -0,0 +1,115 @@
+package cm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/logger/sl"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"log/slog"
+	"time"
+)
+
+type SyncStorage interface {
+	ContactChanges(ctx context.Context, ownerKey string, since int64, limit int) ([]models.ContactChange, int64, error)
+	PurgeTombstones(ctx context.Context, before time.Time) (int64, error)
+}
+
+var (
+	ErrInvalidSyncToken = errors.New("invalid sync token")
+	// ErrSyncExpired means the sync token is too old, so the client has to sync from scratch
+	ErrSyncExpired = errors.New("sync token expired")
+)
+
+// syncToken is passed to clients as an opaque base64 string
+// and holds the number of the last change the client has got
+type syncToken struct {
+	Seq int64 `json:"s"`
+}
+
+func encodeSyncToken(seq int64) string {
+	raw, _ := json.Marshal(syncToken{Seq: seq})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeSyncToken(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, ErrInvalidSyncToken
+	}
+	var token syncToken
+	if err = json.Unmarshal(raw, &token); err != nil || token.Seq < 0 {
+		return 0, ErrInvalidSyncToken
+	}
+	return token.Seq, nil
+}
+
+// SyncContacts returns changes of owner's contacts made since the sync token
+// was issued and the token for the next sync. Empty token returns all contacts.
+// When more is true, there are more changes and the next token should be used right away
+func (cmg *ContactManager) SyncContacts(
+	ctx context.Context,
+	ownerKey string,
+	token string,
+	pageSize int,
+) (changes []models.ContactChange, nextToken string, more bool, err error) {
+	const op = "cm.SyncContacts"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("syncing contacts")
+
+	since, err := decodeSyncToken(token)
+	if err != nil {
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultSyncPageSize
+	}
+	if pageSize > MaxSyncPageSize {
+		pageSize = MaxSyncPageSize
+	}
+
+	changes, seq, err := cmg.syncStorage.ContactChanges(ctx, ownerKey, since, pageSize+1)
+	if err != nil {
+		if errors.Is(err, storage.ErrSyncExpired) {
+			return nil, "", false, fmt.Errorf("%s: %w", op, ErrSyncExpired)
+		}
+		log.Error("failed to list changes", sl.Err(err))
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(changes) > pageSize {
+		changes = changes[:pageSize]
+		return changes, encodeSyncToken(changes[pageSize-1].Seq), true, nil
+	}
+	return changes, encodeSyncToken(seq), false, nil
+}
+
+// PurgeExpiredTombstones forgets contacts purged from trash longer than retention ago
+// and returns their number. Sync tokens issued before that expire
+func (cmg *ContactManager) PurgeExpiredTombstones(
+	ctx context.Context,
+	retention time.Duration,
+) (int64, error) {
+	const op = "cm.PurgeExpiredTombstones"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+
+	n, err := cmg.syncStorage.PurgeTombstones(ctx, time.Now().Add(-retention))
+	if err != nil {
+		log.Error("failed to purge tombstones", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if n > 0 {
+		log.Info("purged tombstones", slog.Int64("count", n))
+	}
+	return n, nil
+}
@@ -0,0 +1,130 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/logger/sl"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"log/slog"
+	"time"
+)
+
+// ListDeletedContacts returns a page of owner's contacts in trash,
+// the most recently deleted first, and the token of the next page
+func (cmg *ContactManager) ListDeletedContacts(
+	ctx context.Context,
+	ownerKey string,
+	pageSize int,
+	pageToken string,
+) ([]models.Contact, string, error) {
+	return cmg.ListContacts(
+		ctx,
+		ownerKey,
+		models.ContactFilter{Deleted: true},
+		models.OrderByDeletedAt,
+		true,
+		pageSize,
+		pageToken,
+	)
+}
+
+// RestoreContact returns the contact from trash. The user must own the contact
+// or have write access to it, though contacts in trash are hidden from grantees
+func (cmg *ContactManager) RestoreContact(
+	ctx context.Context,
+	ownerKey string,
+	id, version int64,
+) error {
+	const op = "cm.RestoreContact"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("id", id),
+	)
+	log.Info("restoring contact")
+
+	contactOwner, err := cmg.contactOwnerKey(ctx, ownerKey, id, models.AccessWrite)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = cmg.contactDeleter.RestoreContact(ctx, contactOwner, id, version, authorFromContext(ctx, ownerKey))
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return fmt.Errorf("%s: %w", op, ErrVersionMismatch)
+		}
+		if errors.Is(err, storage.ErrContactExists) {
+			log.Warn("contact already exists", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, contactExistsError(err))
+		}
+		log.Error("failed to restore contact", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	contact, err := cmg.ownContact(ctx, contactOwner, id)
+	if err != nil {
+		log.Error("failed to get restored contact", sl.Err(err))
+		return nil
+	}
+	cmg.publish(ctx, contactOwner, id, models.ContactCreated, snapshot(contact))
+	return nil
+}
+
+// PurgeContact permanently removes the contact from trash. The user must own the contact
+// or have write access to it. Contacts not in trash are not found
+func (cmg *ContactManager) PurgeContact(
+	ctx context.Context,
+	ownerKey string,
+	id, version int64,
+) error {
+	const op = "cm.PurgeContact"
+	log := cmg.log.With(
+		slog.String("op", op),
+		slog.Int64("id", id),
+	)
+	log.Info("purging contact")
+
+	contactOwner, err := cmg.contactOwnerKey(ctx, ownerKey, id, models.AccessWrite)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = cmg.contactDeleter.PurgeContact(ctx, contactOwner, id, version, authorFromContext(ctx, ownerKey))
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrContactNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return fmt.Errorf("%s: %w", op, ErrVersionMismatch)
+		}
+		log.Error("failed to purge contact", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// PurgeExpiredContacts permanently removes contacts that have been in trash
+// longer than retention and returns their number
+func (cmg *ContactManager) PurgeExpiredContacts(
+	ctx context.Context,
+	retention time.Duration,
+) (int64, error) {
+	const op = "cm.PurgeExpiredContacts"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+
+	n, err := cmg.contactDeleter.PurgeDeletedContacts(ctx, time.Now().Add(-retention))
+	if err != nil {
+		log.Error("failed to purge contacts", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if n > 0 {
+		log.Info("purged contacts", slog.Int64("count", n))
+	}
+	return n, nil
+}
@@ -0,0 +1,36 @@
+package cm
+
+import (
+	"context"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/auth"
+	"gRPC_ContactManagement_Service/internal/lib/logger/sl"
+	"log/slog"
+)
+
+type UserStorage interface {
+	SaveUser(ctx context.Context, id int64, email string) error
+}
+
+// RegisterUser remembers the current email of the authenticated user and returns
+// the key the user's personal data is stored under. Data stored under emails before
+// it was keyed by user id is moved to the key by migration 15, not here
+func (cmg *ContactManager) RegisterUser(
+	ctx context.Context,
+	user auth.Principal,
+) (string, error) {
+	const op = "cm.RegisterUser"
+
+	key := models.UserKey(user.UserID)
+	if email, ok := cmg.knownUsers.Load(user.UserID); ok && email == user.Email {
+		return key, nil
+	}
+
+	if err := cmg.userStorage.SaveUser(ctx, user.UserID, user.Email); err != nil {
+		cmg.log.Error("failed to save user", slog.String("op", op), slog.Int64("user_id", user.UserID), sl.Err(err))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	cmg.knownUsers.Store(user.UserID, user.Email)
+	return key, nil
+}
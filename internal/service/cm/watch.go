@@ -0,0 +1,65 @@
+package cm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/eventbus"
+	"log/slog"
+)
+
+var (
+	// ErrEventsExpired means events after the requested one are no longer kept,
+	// so the watcher has to read contacts anew
+	ErrEventsExpired = errors.New("events expired")
+	// ErrWatchLagged means the watcher didn't keep up with changes and has to resume
+	ErrWatchLagged = errors.New("watcher lagged behind")
+	ErrWatchClosed = errors.New("watch closed")
+)
+
+// WatchContacts calls yield for every change of owner's contacts made after
+// the event afterID until ctx is done or yield fails. Zero afterID watches
+// changes made from now on
+func (cmg *ContactManager) WatchContacts(
+	ctx context.Context,
+	ownerKey string,
+	afterID int64,
+	yield func(models.ContactEvent) error,
+) error {
+	const op = "cm.WatchContacts"
+	log := cmg.log.With(
+		slog.String("op", op),
+	)
+	log.Info("watching contacts", slog.Int64("after_id", afterID))
+
+	sub, err := cmg.events.Subscribe(ownerKey, afterID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, watchError(err))
+	}
+	defer sub.Close()
+
+	for {
+		e, err := sub.Next(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, watchError(err))
+		}
+		event := e.Payload
+		event.ID = e.ID
+		if err = yield(event); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+}
+
+func watchError(err error) error {
+	switch {
+	case errors.Is(err, eventbus.ErrExpired):
+		return ErrEventsExpired
+	case errors.Is(err, eventbus.ErrLagged):
+		return ErrWatchLagged
+	case errors.Is(err, eventbus.ErrClosed):
+		return ErrWatchClosed
+	}
+	return err
+}
@@ -0,0 +1,303 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"time"
+)
+
+const personalBookName = "Personal"
+
+// CreateAddressBook creates a team address book owned by ownerEmail
+func (s *Storage) CreateAddressBook(
+	ctx context.Context,
+	name, ownerEmail string,
+) (int64, error) {
+	const op = "sqlite.CreateAddressBook"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().Unix()
+	// owner_key depends on the id, so a unique placeholder is stored first
+	res, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO address_books(name, owner_key, personal, created_at) VALUES(?, lower(hex(randomblob(16))), 0, ?)",
+		name,
+		now,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE address_books SET owner_key = ? WHERE id = ?", models.TeamBookKey(id), id)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO address_book_members(address_book_id, email, role, created_at) VALUES(?, ?, ?, ?)",
+		id,
+		ownerEmail,
+		models.RoleOwner,
+		now,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+// AddressBooks returns user's personal book followed by team books the user is a member of
+func (s *Storage) AddressBooks(
+	ctx context.Context,
+	ownerKey string,
+) ([]models.AddressBook, error) {
+	const op = "sqlite.AddressBooks"
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT b.id, b.name, b.owner_key, b.personal, ?, b.created_at FROM address_books b
+		WHERE b.personal = 1 AND b.owner_key = ?
+		UNION ALL
+		SELECT b.id, b.name, b.owner_key, b.personal, m.role, b.created_at FROM address_books b
+		JOIN address_book_members m ON m.address_book_id = b.id
+		WHERE m.email = `+userEmail+`
+		ORDER BY 4 DESC, 1`,
+		models.RoleOwner,
+		ownerKey,
+		ownerKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var books []models.AddressBook
+	for rows.Next() {
+		book, err := scanAddressBook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		books = append(books, book)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return books, nil
+}
+
+// AddressBook returns the book with the user's role in it.
+// Fails with storage.ErrBookNotFound if the user has no role in the book
+func (s *Storage) AddressBook(
+	ctx context.Context,
+	ownerKey string,
+	id int64,
+) (models.AddressBook, error) {
+	const op = "sqlite.AddressBook"
+
+	row := s.db.QueryRowContext(
+		ctx,
+		`SELECT b.id, b.name, b.owner_key, b.personal,
+			CASE WHEN b.personal = 1 THEN ? ELSE m.role END, b.created_at
+		FROM address_books b
+		LEFT JOIN address_book_members m ON m.address_book_id = b.id AND m.email = `+userEmail+`
+		WHERE b.id = ? AND (b.personal = 1 AND b.owner_key = ? OR m.role IS NOT NULL)`,
+		models.RoleOwner,
+		ownerKey,
+		id,
+		ownerKey,
+	)
+	book, err := scanAddressBook(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.AddressBook{}, fmt.Errorf("%s: %w", op, storage.ErrBookNotFound)
+		}
+		return models.AddressBook{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return book, nil
+}
+
+// AnyAddressBook returns the book regardless of members, with viewer role in it.
+// Fails with storage.ErrBookNotFound if there is no such book
+func (s *Storage) AnyAddressBook(
+	ctx context.Context,
+	id int64,
+) (models.AddressBook, error) {
+	const op = "sqlite.AnyAddressBook"
+
+	row := s.db.QueryRowContext(
+		ctx,
+		"SELECT id, name, owner_key, personal, ?, created_at FROM address_books WHERE id = ?",
+		models.RoleViewer,
+		id,
+	)
+	book, err := scanAddressBook(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.AddressBook{}, fmt.Errorf("%s: %w", op, storage.ErrBookNotFound)
+		}
+		return models.AddressBook{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return book, nil
+}
+
+// AddressBookMembers returns members of a team book, owners first
+func (s *Storage) AddressBookMembers(
+	ctx context.Context,
+	id int64,
+) ([]models.AddressBookMember, error) {
+	const op = "sqlite.AddressBookMembers"
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		"SELECT email, role, created_at FROM address_book_members WHERE address_book_id = ? ORDER BY role DESC, email",
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var members []models.AddressBookMember
+	for rows.Next() {
+		var (
+			member    models.AddressBookMember
+			createdAt int64
+		)
+		if err = rows.Scan(&member.Email, &member.Role, &createdAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		member.CreatedAt = time.Unix(createdAt, 0)
+		members = append(members, member)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return members, nil
+}
+
+// SaveAddressBookMember adds a member to a team book or changes member's role.
+// Fails with storage.ErrLastBookOwner when the only owner would be demoted
+func (s *Storage) SaveAddressBookMember(
+	ctx context.Context,
+	id int64,
+	email string,
+	role models.BookRole,
+) error {
+	const op = "sqlite.SaveAddressBookMember"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO address_book_members(address_book_id, email, role, created_at) VALUES(?, ?, ?, ?)
+		ON CONFLICT(address_book_id, email) DO UPDATE SET role = excluded.role`,
+		id,
+		email,
+		role,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err = checkBookOwners(ctx, tx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// DeleteAddressBookMember removes a member from a team book.
+// Fails with storage.ErrLastBookOwner when the only owner would be removed
+func (s *Storage) DeleteAddressBookMember(
+	ctx context.Context,
+	id int64,
+	email string,
+) error {
+	const op = "sqlite.DeleteAddressBookMember"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(
+		ctx,
+		"DELETE FROM address_book_members WHERE address_book_id = ? AND email = ?",
+		id,
+		email,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrMemberNotFound)
+	}
+	if err = checkBookOwners(ctx, tx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Checks that a team book still has an owner
+func checkBookOwners(ctx context.Context, q querier, id int64) error {
+	var exists bool
+	err := q.QueryRowContext(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM address_book_members WHERE address_book_id = ? AND role = ?)",
+		id,
+		models.RoleOwner,
+	).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return storage.ErrLastBookOwner
+	}
+	return nil
+}
+
+func scanAddressBook(row scanner) (models.AddressBook, error) {
+	var (
+		book      models.AddressBook
+		createdAt int64
+	)
+	err := row.Scan(&book.ID, &book.Name, &book.OwnerKey, &book.Personal, &book.Role, &createdAt)
+	if err != nil {
+		return models.AddressBook{}, err
+	}
+	book.CreatedAt = time.Unix(createdAt, 0)
+	return book, nil
+}
@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/storage"
+)
+
+// SaveContacts saves contacts and revisions of their creation by author in one transaction
+// and returns ids of saved contacts and errors of failed ones by their position. In atomic mode nothing is saved
+// if any contact fails, and the other contacts get storage.ErrBatchAborted
+func (s *Storage) SaveContacts(
+	ctx context.Context,
+	contacts []models.Contact,
+	author string,
+	atomic bool,
+) ([]int64, []error, error) {
+	const op = "sqlite.SaveContacts"
+
+	ids := make([]int64, len(contacts))
+	errs, err := s.runBatch(ctx, len(contacts), atomic, func(tx *sql.Tx, i int) error {
+		id, err := saveContact(ctx, tx, contacts[i], author)
+		ids[i] = id
+		return err
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for i := range ids {
+		if errs[i] != nil {
+			ids[i] = 0
+		}
+	}
+	return ids, errs, nil
+}
+
+// DeleteContacts moves contacts to trash of their owners and saves revisions of the deletions
+// by author in one transaction and returns errors of failed contacts by their position. In atomic mode nothing is deleted
+// if any contact fails, and the other contacts get storage.ErrBatchAborted
+func (s *Storage) DeleteContacts(
+	ctx context.Context,
+	refs []models.ContactRef,
+	author string,
+	atomic bool,
+) ([]error, error) {
+	const op = "sqlite.DeleteContacts"
+
+	errs, err := s.runBatch(ctx, len(refs), atomic, func(tx *sql.Tx, i int) error {
+		return deleteContact(ctx, tx, refs[i].OwnerKey, refs[i].ID, refs[i].Version, author)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return errs, nil
+}
+
+// Applies n items in one transaction, each under its own savepoint, so a failed item
+// leaves no changes behind. Returns errors of the items by their position.
+// In atomic mode the first failed item rolls back the whole transaction
+func (s *Storage) runBatch(
+	ctx context.Context,
+	n int,
+	atomic bool,
+	apply func(tx *sql.Tx, i int) error,
+) ([]error, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		if _, err = tx.ExecContext(ctx, "SAVEPOINT batch_item"); err != nil {
+			return nil, err
+		}
+		if errs[i] = apply(tx, i); errs[i] != nil {
+			if atomic {
+				for j := range errs {
+					if j != i {
+						errs[j] = storage.ErrBatchAborted
+					}
+				}
+				return errs, nil
+			}
+			if _, err = tx.ExecContext(ctx, "ROLLBACK TO batch_item"); err != nil {
+				return nil, err
+			}
+		}
+		if _, err = tx.ExecContext(ctx, "RELEASE batch_item"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return errs, nil
+}
@@ -0,0 +1,95 @@
+//go:build sqlite_fts5
+
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"gRPC_ContactManagement_Service/internal/storage/sqlite"
+	"testing"
+)
+
+// Returns storage where owner's emails are unique and ivan@example.com is taken
+func newBatchStorage(t *testing.T) (*sqlite.Storage, int64) {
+	t.Helper()
+	st := newStorage(t)
+	if err := st.SetUniquenessPolicy(context.Background(), owner, models.UniquenessPolicy{UniqueEmail: true}); err != nil {
+		t.Fatal(err)
+	}
+	return st, saveContact(t, st, "ivan")
+}
+
+func TestSaveContactsAtomic(t *testing.T) {
+	st, ivan := newBatchStorage(t)
+	batch := []models.Contact{newContact("anna"), newContact("ivan"), newContact("olga")}
+
+	ids, errs, err := st.SaveContacts(context.Background(), batch, owner, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []error{storage.ErrBatchAborted, storage.ErrContactExists, storage.ErrBatchAborted} {
+		if !errors.Is(errs[i], want) {
+			t.Errorf("errs[%d] = %v, want %v", i, errs[i], want)
+		}
+		if ids[i] != 0 {
+			t.Errorf("ids[%d] = %d, want 0", i, ids[i])
+		}
+	}
+
+	// the whole batch is rolled back
+	changes, _ := changesOf(t, st, owner, 0)
+	assertChanges(t, changes, ivan)
+}
+
+func TestSaveContactsBestEffort(t *testing.T) {
+	st, ivan := newBatchStorage(t)
+	batch := []models.Contact{newContact("anna"), newContact("ivan"), newContact("olga")}
+
+	ids, errs, err := st.SaveContacts(context.Background(), batch, owner, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs[0] != nil || errs[2] != nil {
+		t.Errorf("errs = %v, want only the duplicate to fail", errs)
+	}
+	if !errors.Is(errs[1], storage.ErrContactExists) {
+		t.Errorf("errs[1] = %v, want %v", errs[1], storage.ErrContactExists)
+	}
+	if ids[0] == 0 || ids[1] != 0 || ids[2] == 0 {
+		t.Errorf("ids = %v", ids)
+	}
+
+	changes, _ := changesOf(t, st, owner, 0)
+	assertChanges(t, changes, ivan, ids[0], ids[2])
+}
+
+func TestDeleteContacts(t *testing.T) {
+	st, ivan := newBatchStorage(t)
+	anna := saveContact(t, st, "anna")
+	ctx := context.Background()
+	refs := []models.ContactRef{{OwnerKey: owner, ID: ivan}, {OwnerKey: owner, ID: anna + 100}, {OwnerKey: owner, ID: anna}}
+
+	errs, err := st.DeleteContacts(ctx, refs, owner, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []error{storage.ErrBatchAborted, storage.ErrContactNotFound, storage.ErrBatchAborted} {
+		if !errors.Is(errs[i], want) {
+			t.Errorf("atomic: errs[%d] = %v, want %v", i, errs[i], want)
+		}
+	}
+	changes, _ := changesOf(t, st, owner, 0)
+	assertChanges(t, changes, ivan, anna)
+
+	errs, err = st.DeleteContacts(ctx, refs, owner, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs[0] != nil || !errors.Is(errs[1], storage.ErrContactNotFound) || errs[2] != nil {
+		t.Errorf("best effort: errs = %v", errs)
+	}
+	changes, _ = changesOf(t, st, owner, 0)
+	assertChanges(t, changes)
+}
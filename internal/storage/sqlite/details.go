@@ -0,0 +1,147 @@
+package sqlite
+
+import (
+	"context"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"strings"
+)
+
+// Emails and phones of a contact are kept in child tables contact_emails
+// and contact_phones, contacts.email and contacts.phone duplicate the primary ones.
+// Tags are linked to contacts through contact_tags.
+
+func saveEmails(ctx context.Context, q querier, contactID int64, emails []models.ContactEmail) error {
+	for _, email := range emails {
+		_, err := q.ExecContext(
+			ctx,
+			"INSERT INTO contact_emails(contact_id, email, label, is_primary) VALUES(?, ?, ?, ?)",
+			contactID,
+			email.Email,
+			string(email.Label),
+			email.Primary,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func savePhones(ctx context.Context, q querier, contactID int64, phones []models.ContactPhone) error {
+	for _, phone := range phones {
+		_, err := q.ExecContext(
+			ctx,
+			"INSERT INTO contact_phones(contact_id, phone, label, is_primary) VALUES(?, ?, ?, ?)",
+			contactID,
+			phone.Phone,
+			string(phone.Label),
+			phone.Primary,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func replaceEmails(ctx context.Context, q querier, contactID int64, emails []models.ContactEmail) error {
+	if _, err := q.ExecContext(ctx, "DELETE FROM contact_emails WHERE contact_id = ?", contactID); err != nil {
+		return err
+	}
+	return saveEmails(ctx, q, contactID, emails)
+}
+
+func replacePhones(ctx context.Context, q querier, contactID int64, phones []models.ContactPhone) error {
+	if _, err := q.ExecContext(ctx, "DELETE FROM contact_phones WHERE contact_id = ?", contactID); err != nil {
+		return err
+	}
+	return savePhones(ctx, q, contactID, phones)
+}
+
+// Loads emails, phones and tags of contacts, primary emails and phones go first
+func loadDetails(ctx context.Context, q querier, contacts []models.Contact) error {
+	if len(contacts) == 0 {
+		return nil
+	}
+
+	index := make(map[int64]int, len(contacts))
+	ids := make([]any, 0, len(contacts))
+	for i, contact := range contacts {
+		index[contact.ID] = i
+		ids = append(ids, contact.ID)
+	}
+	in := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ") + ")"
+
+	rows, err := q.QueryContext(
+		ctx,
+		"SELECT contact_id, email, label, is_primary FROM contact_emails WHERE contact_id IN "+in+" ORDER BY is_primary DESC, id",
+		ids...,
+	)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var (
+			contactID int64
+			email     models.ContactEmail
+		)
+		if err = rows.Scan(&contactID, &email.Email, &email.Label, &email.Primary); err != nil {
+			rows.Close()
+			return err
+		}
+		i := index[contactID]
+		contacts[i].Emails = append(contacts[i].Emails, email)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	rows, err = q.QueryContext(
+		ctx,
+		"SELECT contact_id, phone, label, is_primary FROM contact_phones WHERE contact_id IN "+in+" ORDER BY is_primary DESC, id",
+		ids...,
+	)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var (
+			contactID int64
+			phone     models.ContactPhone
+		)
+		if err = rows.Scan(&contactID, &phone.Phone, &phone.Label, &phone.Primary); err != nil {
+			rows.Close()
+			return err
+		}
+		i := index[contactID]
+		contacts[i].Phones = append(contacts[i].Phones, phone)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	rows, err = q.QueryContext(
+		ctx,
+		"SELECT ct.contact_id, t.name FROM contact_tags ct JOIN tags t ON t.id = ct.tag_id WHERE ct.contact_id IN "+in+" ORDER BY t.name",
+		ids...,
+	)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var (
+			contactID int64
+			tag       string
+		)
+		if err = rows.Scan(&contactID, &tag); err != nil {
+			rows.Close()
+			return err
+		}
+		i := index[contactID]
+		contacts[i].Tags = append(contacts[i].Tags, tag)
+	}
+	rows.Close()
+	return rows.Err()
+}
@@ -0,0 +1,362 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"github.com/mattn/go-sqlite3"
+	"time"
+)
+
+func (s *Storage) SaveGroup(
+	ctx context.Context,
+	ownerKey, name string,
+) (int64, error) {
+	const op = "sqlite.SaveGroup"
+
+	res, err := s.db.ExecContext(
+		ctx,
+		"INSERT INTO contact_groups(owner_key, name, created_at) VALUES(?, ?, ?)",
+		ownerKey,
+		name,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrGroupExists)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (s *Storage) RenameGroup(
+	ctx context.Context,
+	ownerKey string,
+	id int64,
+	name string,
+) error {
+	const op = "sqlite.RenameGroup"
+
+	res, err := s.db.ExecContext(
+		ctx,
+		"UPDATE contact_groups SET name = ? WHERE owner_key = ? AND id = ?",
+		name,
+		ownerKey,
+		id,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrGroupExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrGroupNotFound)
+	}
+	return nil
+}
+
+func (s *Storage) DeleteGroup(
+	ctx context.Context,
+	ownerKey string,
+	id int64,
+) error {
+	const op = "sqlite.DeleteGroup"
+
+	res, err := s.db.ExecContext(ctx, "DELETE FROM contact_groups WHERE owner_key = ? AND id = ?", ownerKey, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrGroupNotFound)
+	}
+	return nil
+}
+
+// Groups returns all owner's groups ordered by name
+func (s *Storage) Groups(
+	ctx context.Context,
+	ownerKey string,
+) ([]models.Group, error) {
+	const op = "sqlite.Groups"
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT g.id, g.owner_key, g.name, g.created_at, COUNT(m.contact_id)
+		FROM contact_groups g
+		LEFT JOIN contact_group_members m ON m.group_id = g.id
+			AND m.contact_id IN (SELECT id FROM contacts WHERE deleted_at IS NULL)
+		WHERE g.owner_key = ?
+		GROUP BY g.id
+		ORDER BY g.name, g.id`,
+		ownerKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var groups []models.Group
+	for rows.Next() {
+		var (
+			group     models.Group
+			createdAt int64
+		)
+		err = rows.Scan(&group.ID, &group.OwnerKey, &group.Name, &createdAt, &group.MembersCount)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		group.CreatedAt = time.Unix(createdAt, 0)
+		groups = append(groups, group)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return groups, nil
+}
+
+// AddGroupMembers adds owner's contacts to the group.
+// Contacts that are already members are skipped
+func (s *Storage) AddGroupMembers(
+	ctx context.Context,
+	ownerKey string,
+	groupID int64,
+	contactIDs []int64,
+) error {
+	const op = "sqlite.AddGroupMembers"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err = checkGroupOwner(ctx, tx, ownerKey, groupID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err = checkContactsOwner(ctx, tx, ownerKey, contactIDs); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, contactID := range contactIDs {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT OR IGNORE INTO contact_group_members(group_id, contact_id) VALUES(?, ?)",
+			groupID,
+			contactID,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// RemoveGroupMembers removes contacts from the group.
+// Contacts that are not members are skipped
+func (s *Storage) RemoveGroupMembers(
+	ctx context.Context,
+	ownerKey string,
+	groupID int64,
+	contactIDs []int64,
+) error {
+	const op = "sqlite.RemoveGroupMembers"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err = checkGroupOwner(ctx, tx, ownerKey, groupID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, contactID := range contactIDs {
+		_, err = tx.ExecContext(
+			ctx,
+			"DELETE FROM contact_group_members WHERE group_id = ? AND contact_id = ?",
+			groupID,
+			contactID,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// AddContactTags tags owner's contact. Unknown tags are created
+func (s *Storage) AddContactTags(
+	ctx context.Context,
+	ownerKey string,
+	contactID, version int64,
+	tags []string,
+) error {
+	const op = "sqlite.AddContactTags"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(
+		ctx,
+		"UPDATE contacts SET version = version + 1 WHERE owner_key = ? AND id = ? AND deleted_at IS NULL"+versionCond,
+		ownerKey,
+		contactID,
+		version,
+		version,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err = expectChanged(ctx, tx, res, ownerKey, contactID, false); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, tag := range tags {
+		_, err = tx.ExecContext(ctx, "INSERT OR IGNORE INTO tags(owner_key, name) VALUES(?, ?)", ownerKey, tag)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT OR IGNORE INTO contact_tags(contact_id, tag_id) SELECT ?, id FROM tags WHERE owner_key = ? AND name = ?",
+			contactID,
+			ownerKey,
+			tag,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *Storage) RemoveContactTags(
+	ctx context.Context,
+	ownerKey string,
+	contactID, version int64,
+	tags []string,
+) error {
+	const op = "sqlite.RemoveContactTags"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(
+		ctx,
+		"UPDATE contacts SET version = version + 1 WHERE owner_key = ? AND id = ? AND deleted_at IS NULL"+versionCond,
+		ownerKey,
+		contactID,
+		version,
+		version,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err = expectChanged(ctx, tx, res, ownerKey, contactID, false); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, tag := range tags {
+		_, err = tx.ExecContext(
+			ctx,
+			"DELETE FROM contact_tags WHERE contact_id = ? AND tag_id IN (SELECT id FROM tags WHERE owner_key = ? AND name = ?)",
+			contactID,
+			ownerKey,
+			tag,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func checkGroupOwner(ctx context.Context, q querier, ownerKey string, groupID int64) error {
+	var id int64
+	err := q.QueryRowContext(
+		ctx,
+		"SELECT id FROM contact_groups WHERE owner_key = ? AND id = ?",
+		ownerKey,
+		groupID,
+	).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return storage.ErrGroupNotFound
+	}
+	return err
+}
+
+// Checks that all contacts exist, belong to owner and are not in trash
+func checkContactsOwner(ctx context.Context, q querier, ownerKey string, contactIDs []int64) error {
+	if len(contactIDs) == 0 {
+		return nil
+	}
+
+	unique := make(map[int64]struct{}, len(contactIDs))
+	args := []any{ownerKey}
+	for _, id := range contactIDs {
+		unique[id] = struct{}{}
+		args = append(args, id)
+	}
+
+	var count int
+	err := q.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM contacts WHERE owner_key = ? AND deleted_at IS NULL AND id IN "+placeholders(len(contactIDs)),
+		args...,
+	).Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count != len(unique) {
+		return storage.ErrContactNotFound
+	}
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+}
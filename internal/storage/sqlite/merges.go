@@ -0,0 +1,448 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/translit"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"strings"
+	"time"
+)
+
+// MergeContacts merges owner's contacts into the survivor and returns id of the merge.
+// Survivor keeps its name and primary values and gets emails, phones, tags and groups
+// of the merged contacts, merged contacts are moved to trash. Revisions of the changes
+// are saved by author. Zero version merges regardless of the survivor's version
+func (s *Storage) MergeContacts(
+	ctx context.Context,
+	ownerKey string,
+	survivorID, version int64,
+	mergedIDs []int64,
+	author string,
+) (int64, error) {
+	const op = "sqlite.MergeContacts"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(
+		ctx,
+		"UPDATE contacts SET version = version + 1 WHERE owner_key = ? AND id = ? AND deleted_at IS NULL"+versionCond,
+		ownerKey,
+		survivorID,
+		version,
+		version,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = expectChanged(ctx, tx, res, ownerKey, survivorID, false); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = checkContactsOwner(ctx, tx, ownerKey, mergedIDs); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ids := append([]int64{survivorID}, mergedIDs...)
+	args := []any{ownerKey}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	contacts, err := queryContacts(
+		ctx,
+		tx,
+		"SELECT "+contactColumns+" FROM contacts WHERE owner_key = ? AND id IN "+placeholders(len(ids)),
+		args...,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var survivor models.Contact
+	for _, contact := range contacts {
+		if contact.ID == survivorID {
+			survivor = contact
+		}
+	}
+	before := snapshotOf(survivor)
+
+	emails, phones := survivor.Emails, survivor.Phones
+	for _, contact := range contacts {
+		if contact.ID == survivorID {
+			continue
+		}
+		for _, e := range contact.Emails {
+			if !containsEmail(emails, e.Email) {
+				emails = append(emails, models.ContactEmail{Email: e.Email, Label: e.Label})
+			}
+		}
+		for _, p := range contact.Phones {
+			if !containsPhone(phones, p.Phone) {
+				phones = append(phones, models.ContactPhone{Phone: p.Phone, Label: p.Label})
+			}
+		}
+	}
+	if err = replaceEmails(ctx, tx, survivorID, emails); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = replacePhones(ctx, tx, survivorID, phones); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	addedTags, err := copyLinks(ctx, tx, "contact_tags", "tag_id", survivorID, mergedIDs)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	addedGroups, err := copyLinks(ctx, tx, "contact_group_members", "group_id", survivorID, mergedIDs)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	args = []any{time.Now().Unix()}
+	for _, id := range mergedIDs {
+		args = append(args, id)
+	}
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE contacts SET deleted_at = ?, version = version + 1 WHERE id IN "+placeholders(len(mergedIDs)),
+		args...,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	// Merged contacts release their unique values before the survivor claims them
+	for _, id := range append(append([]int64{}, mergedIDs...), survivorID) {
+		if err = syncUniqueKeys(ctx, tx, ownerKey, id); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	after, err := contactSnapshot(ctx, tx, survivorID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	revisions := []models.Revision{{ContactID: survivorID, Action: models.RevisionUpdate, Before: before, After: after}}
+	for _, contact := range contacts {
+		if contact.ID != survivorID {
+			revisions = append(revisions, models.Revision{ContactID: contact.ID, Action: models.RevisionDelete, Before: snapshotOf(contact)})
+		}
+	}
+	for _, rev := range revisions {
+		rev.OwnerKey, rev.Author = ownerKey, author
+		if err = saveRevision(ctx, tx, rev); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	beforeJSON, err := encodeSnapshot(before)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	mergedJSON, _ := json.Marshal(mergedIDs)
+	tagsJSON, _ := json.Marshal(addedTags)
+	groupsJSON, _ := json.Marshal(addedGroups)
+	res, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO contact_merges(
+			owner_key, survivor_id, merged_ids, survivor_before, survivor_version, added_tag_ids, added_group_ids, created_at
+		) VALUES(?, ?, ?, ?, (SELECT version FROM contacts WHERE id = ?), ?, ?, ?)`,
+		ownerKey,
+		survivorID,
+		string(mergedJSON),
+		beforeJSON,
+		survivorID,
+		string(tagsJSON),
+		string(groupsJSON),
+		time.Now().Unix(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	mergeID, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return mergeID, nil
+}
+
+// Merge returns owner's merge that has not been undone
+func (s *Storage) Merge(
+	ctx context.Context,
+	ownerKey string,
+	id int64,
+) (models.Merge, error) {
+	const op = "sqlite.Merge"
+
+	merge, _, err := queryMerge(ctx, s.db, ownerKey, id)
+	if err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return merge, nil
+}
+
+// UndoMerge restores merged contacts from trash and returns the survivor
+// to its state before the merge, revisions of the changes are saved by author.
+// Undo fails with storage.ErrVersionMismatch if the survivor has been changed since the merge
+func (s *Storage) UndoMerge(
+	ctx context.Context,
+	ownerKey string,
+	id int64,
+	author string,
+) (models.Merge, error) {
+	const op = "sqlite.UndoMerge"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	merge, undo, err := queryMerge(ctx, tx, ownerKey, id)
+	if err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	before, err := contactSnapshot(ctx, tx, merge.SurvivorID)
+	if err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	email, phone := "", ""
+	for _, e := range undo.before.Emails {
+		if e.Primary {
+			email = e.Email
+		}
+	}
+	for _, p := range undo.before.Phones {
+		if p.Primary {
+			phone = p.Phone
+		}
+	}
+	res, err := tx.ExecContext(
+		ctx,
+		`UPDATE contacts SET name = ?, name_latin = ?, email = ?, phone = ?, version = version + 1
+		WHERE owner_key = ? AND id = ? AND deleted_at IS NULL AND version = ?`,
+		undo.before.Name,
+		translit.Key(undo.before.Name),
+		email,
+		phone,
+		ownerKey,
+		merge.SurvivorID,
+		undo.survivorVersion,
+	)
+	if err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = expectChanged(ctx, tx, res, ownerKey, merge.SurvivorID, false); err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = replaceEmails(ctx, tx, merge.SurvivorID, undo.before.Emails); err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = replacePhones(ctx, tx, merge.SurvivorID, undo.before.Phones); err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = deleteLinks(ctx, tx, "contact_tags", "tag_id", merge.SurvivorID, undo.addedTags); err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = deleteLinks(ctx, tx, "contact_group_members", "group_id", merge.SurvivorID, undo.addedGroups); err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	args := []any{ownerKey}
+	for _, id := range merge.MergedIDs {
+		args = append(args, id)
+	}
+	res, err = tx.ExecContext(
+		ctx,
+		"UPDATE contacts SET deleted_at = NULL, version = version + 1 WHERE owner_key = ? AND deleted_at IS NOT NULL AND id IN "+placeholders(len(merge.MergedIDs)),
+		args...,
+	)
+	if err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+	// Merged contacts have been purged or restored from trash by hand
+	if n != int64(len(merge.MergedIDs)) {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, storage.ErrContactNotFound)
+	}
+	// The survivor releases unique values of the merged contacts before they claim them back
+	for _, id := range append([]int64{merge.SurvivorID}, merge.MergedIDs...) {
+		if err = syncUniqueKeys(ctx, tx, ownerKey, id); err != nil {
+			return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	revisions := []models.Revision{{ContactID: merge.SurvivorID, Action: models.RevisionUpdate, Before: before}}
+	for _, id := range merge.MergedIDs {
+		revisions = append(revisions, models.Revision{ContactID: id, Action: models.RevisionRestore})
+	}
+	for _, rev := range revisions {
+		if rev.After, err = contactSnapshot(ctx, tx, rev.ContactID); err != nil {
+			return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+		}
+		rev.OwnerKey, rev.Author = ownerKey, author
+		if err = saveRevision(ctx, tx, rev); err != nil {
+			return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE contact_merges SET undone_at = ? WHERE id = ?", time.Now().Unix(), id)
+	if err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.Merge{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return merge, nil
+}
+
+// What is needed to undo a merge
+type mergeUndo struct {
+	before          models.ContactSnapshot
+	survivorVersion int64
+	addedTags       []int64
+	addedGroups     []int64
+}
+
+func queryMerge(ctx context.Context, q querier, ownerKey string, id int64) (models.Merge, mergeUndo, error) {
+	var (
+		merge                           models.Merge
+		undo                            mergeUndo
+		createdAt                       int64
+		mergedIDs, before, tags, groups string
+	)
+	err := q.QueryRowContext(
+		ctx,
+		`SELECT id, owner_key, survivor_id, merged_ids, survivor_before, survivor_version, added_tag_ids, added_group_ids, created_at
+		FROM contact_merges WHERE owner_key = ? AND id = ? AND undone_at IS NULL`,
+		ownerKey,
+		id,
+	).Scan(
+		&merge.ID,
+		&merge.OwnerKey,
+		&merge.SurvivorID,
+		&mergedIDs,
+		&before,
+		&undo.survivorVersion,
+		&tags,
+		&groups,
+		&createdAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Merge{}, mergeUndo{}, storage.ErrMergeNotFound
+	}
+	if err != nil {
+		return models.Merge{}, mergeUndo{}, err
+	}
+	merge.CreatedAt = time.Unix(createdAt, 0)
+
+	for _, v := range []struct {
+		data string
+		dst  any
+	}{
+		{mergedIDs, &merge.MergedIDs},
+		{before, &undo.before},
+		{tags, &undo.addedTags},
+		{groups, &undo.addedGroups},
+	} {
+		if err = json.Unmarshal([]byte(v.data), v.dst); err != nil {
+			return models.Merge{}, mergeUndo{}, err
+		}
+	}
+	return merge, undo, nil
+}
+
+// Links survivor to the tags or groups of the merged contacts it is not linked to yet
+// and returns ids of the new links. table is a link table with contact_id column
+func copyLinks(ctx context.Context, q querier, table, column string, survivorID int64, mergedIDs []int64) ([]int64, error) {
+	args := make([]any, 0, len(mergedIDs)+1)
+	for _, id := range mergedIDs {
+		args = append(args, id)
+	}
+	args = append(args, survivorID)
+	rows, err := q.QueryContext(
+		ctx,
+		"SELECT DISTINCT "+column+" FROM "+table+" WHERE contact_id IN "+placeholders(len(mergedIDs))+
+			" AND "+column+" NOT IN (SELECT "+column+" FROM "+table+" WHERE contact_id = ?)",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	added := []int64{}
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		added = append(added, id)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, id := range added {
+		_, err = q.ExecContext(ctx, "INSERT INTO "+table+"(contact_id, "+column+") VALUES(?, ?)", survivorID, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return added, nil
+}
+
+func deleteLinks(ctx context.Context, q querier, table, column string, contactID int64, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	args := []any{contactID}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	_, err := q.ExecContext(
+		ctx,
+		"DELETE FROM "+table+" WHERE contact_id = ? AND "+column+" IN "+placeholders(len(ids)),
+		args...,
+	)
+	return err
+}
+
+// Returns "(?, ?, ...)" with n placeholders for IN clause
+func placeholders(n int) string {
+	return "(" + strings.TrimSuffix(strings.Repeat("?, ", n), ", ") + ")"
+}
+
+func containsEmail(emails []models.ContactEmail, email string) bool {
+	for _, e := range emails {
+		if strings.EqualFold(e.Email, email) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsPhone(phones []models.ContactPhone, phone string) bool {
+	for _, p := range phones {
+		if p.Phone == phone {
+			return true
+		}
+	}
+	return false
+}
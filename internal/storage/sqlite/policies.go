@@ -0,0 +1,207 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"strings"
+)
+
+// Fields of contact_unique_keys
+const (
+	uniqueEmail = "email"
+	uniquePhone = "phone"
+)
+
+// UniquenessPolicy returns owner's policy. Nothing is unique by default
+func (s *Storage) UniquenessPolicy(
+	ctx context.Context,
+	ownerKey string,
+) (models.UniquenessPolicy, error) {
+	const op = "sqlite.UniquenessPolicy"
+
+	policy, err := queryPolicy(ctx, s.db, ownerKey)
+	if err != nil {
+		return models.UniquenessPolicy{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return policy, nil
+}
+
+// SetUniquenessPolicy changes owner's policy and claims unique values of all
+// owner's contacts anew. Fails with *models.ContactExistsError
+// if existing contacts already violate the new policy
+func (s *Storage) SetUniquenessPolicy(
+	ctx context.Context,
+	ownerKey string,
+	policy models.UniquenessPolicy,
+) error {
+	const op = "sqlite.SetUniquenessPolicy"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO uniqueness_policies(owner_key, unique_email, unique_phone) VALUES(?, ?, ?)
+		ON CONFLICT(owner_key) DO UPDATE SET unique_email = excluded.unique_email, unique_phone = excluded.unique_phone`,
+		ownerKey,
+		policy.UniqueEmail,
+		policy.UniquePhone,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM contact_unique_keys WHERE owner_key = ?", ownerKey); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if policy.UniqueEmail {
+		err = claimAll(ctx, tx, ownerKey, uniqueEmail, "SELECT e.contact_id, LOWER(e.email) FROM contact_emails e")
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	if policy.UniquePhone {
+		err = claimAll(ctx, tx, ownerKey, uniquePhone, "SELECT e.contact_id, e.phone FROM contact_phones e")
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Claims values selected by query from contact_emails or contact_phones aliased as e
+// for all owner's contacts out of trash
+func claimAll(ctx context.Context, q querier, ownerKey, field, query string) error {
+	rows, err := q.QueryContext(
+		ctx,
+		query+" JOIN contacts c ON c.id = e.contact_id WHERE c.owner_key = ? AND c.deleted_at IS NULL ORDER BY c.id",
+		ownerKey,
+	)
+	if err != nil {
+		return err
+	}
+	type key struct {
+		contactID int64
+		value     string
+	}
+	var keys []key
+	for rows.Next() {
+		var k key
+		if err = rows.Scan(&k.contactID, &k.value); err != nil {
+			rows.Close()
+			return err
+		}
+		keys = append(keys, k)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		if err = claimKey(ctx, q, ownerKey, field, k.value, k.contactID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Claims unique emails and phones of the contact under owner's policy
+// and releases the ones it no longer has. Contacts in trash claim nothing.
+// Must be called in the transaction that changes the contact
+func syncUniqueKeys(ctx context.Context, q querier, ownerKey string, contactID int64) error {
+	if _, err := q.ExecContext(ctx, "DELETE FROM contact_unique_keys WHERE contact_id = ?", contactID); err != nil {
+		return err
+	}
+
+	policy, err := queryPolicy(ctx, q, ownerKey)
+	if err != nil {
+		return err
+	}
+	if !policy.UniqueEmail && !policy.UniquePhone {
+		return nil
+	}
+
+	contacts, err := queryContacts(
+		ctx,
+		q,
+		"SELECT "+contactColumns+" FROM contacts WHERE owner_key = ? AND id = ? AND deleted_at IS NULL",
+		ownerKey,
+		contactID,
+	)
+	if err != nil || len(contacts) == 0 {
+		return err
+	}
+
+	if policy.UniqueEmail {
+		for _, e := range contacts[0].Emails {
+			if err = claimKey(ctx, q, ownerKey, uniqueEmail, strings.ToLower(e.Email), contactID); err != nil {
+				return err
+			}
+		}
+	}
+	if policy.UniquePhone {
+		for _, p := range contacts[0].Phones {
+			if err = claimKey(ctx, q, ownerKey, uniquePhone, p.Phone, contactID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns storage.ErrContactExists wrapping *models.ContactExistsError if the value is claimed by another contact
+func claimKey(ctx context.Context, q querier, ownerKey, field, value string, contactID int64) error {
+	_, err := q.ExecContext(
+		ctx,
+		"INSERT INTO contact_unique_keys(owner_key, field, value, contact_id) VALUES(?, ?, ?, ?)",
+		ownerKey,
+		field,
+		value,
+		contactID,
+	)
+	if err == nil || !isUniqueViolation(err) {
+		return err
+	}
+
+	var existingID int64
+	err = q.QueryRowContext(
+		ctx,
+		"SELECT contact_id FROM contact_unique_keys WHERE owner_key = ? AND field = ? AND value = ?",
+		ownerKey,
+		field,
+		value,
+	).Scan(&existingID)
+	if err != nil {
+		return err
+	}
+	// The contact has the same value twice
+	if existingID == contactID {
+		return nil
+	}
+	return fmt.Errorf("%w: %w", storage.ErrContactExists, &models.ContactExistsError{Field: field, ExistingID: existingID})
+}
+
+func queryPolicy(ctx context.Context, q querier, ownerKey string) (models.UniquenessPolicy, error) {
+	var policy models.UniquenessPolicy
+	err := q.QueryRowContext(
+		ctx,
+		"SELECT unique_email, unique_phone FROM uniqueness_policies WHERE owner_key = ?",
+		ownerKey,
+	).Scan(&policy.UniqueEmail, &policy.UniquePhone)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return models.UniquenessPolicy{}, err
+	}
+	return policy, nil
+}
@@ -0,0 +1,176 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"time"
+)
+
+const revisionColumns = "id, contact_id, owner_key, author, action, created_at, before, after"
+
+// Appends revision of a change made in the same transaction to the contact's history,
+// so the change and its revision are saved or rolled back together.
+// Revisions can't be changed or removed once saved
+func saveRevision(ctx context.Context, q querier, rev models.Revision) error {
+	before, err := encodeSnapshot(rev.Before)
+	if err != nil {
+		return err
+	}
+	after, err := encodeSnapshot(rev.After)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.ExecContext(
+		ctx,
+		"INSERT INTO contact_revisions(contact_id, owner_key, author, action, created_at, before, after) VALUES(?, ?, ?, ?, ?, ?, ?)",
+		rev.ContactID,
+		rev.OwnerKey,
+		rev.Author,
+		string(rev.Action),
+		time.Now().Unix(),
+		before,
+		after,
+	)
+	return err
+}
+
+// Returns the state of the contact kept in revisions, nil if there is no such contact
+func contactSnapshot(ctx context.Context, q querier, id int64) (*models.ContactSnapshot, error) {
+	contacts, err := queryContacts(ctx, q, "SELECT "+contactColumns+" FROM contacts WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(contacts) == 0 {
+		return nil, nil
+	}
+	return snapshotOf(contacts[0]), nil
+}
+
+func snapshotOf(contact models.Contact) *models.ContactSnapshot {
+	return &models.ContactSnapshot{
+		Name:   contact.Name,
+		Emails: contact.Emails,
+		Phones: contact.Phones,
+	}
+}
+
+// Revisions returns the history of owner's contact, the latest revision first.
+// History is kept after the contact is purged
+func (s *Storage) Revisions(
+	ctx context.Context,
+	ownerKey string,
+	contactID int64,
+) ([]models.Revision, error) {
+	const op = "sqlite.Revisions"
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		"SELECT "+revisionColumns+" FROM contact_revisions WHERE owner_key = ? AND contact_id = ? ORDER BY id DESC",
+		ownerKey,
+		contactID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var revisions []models.Revision
+	for rows.Next() {
+		rev, err := scanRevision(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		revisions = append(revisions, rev)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return revisions, nil
+}
+
+func (s *Storage) Revision(
+	ctx context.Context,
+	ownerKey string,
+	contactID, id int64,
+) (models.Revision, error) {
+	const op = "sqlite.Revision"
+
+	row := s.db.QueryRowContext(
+		ctx,
+		"SELECT "+revisionColumns+" FROM contact_revisions WHERE owner_key = ? AND contact_id = ? AND id = ?",
+		ownerKey,
+		contactID,
+		id,
+	)
+	rev, err := scanRevision(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Revision{}, fmt.Errorf("%s: %w", op, storage.ErrRevisionNotFound)
+		}
+		return models.Revision{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return rev, nil
+}
+
+// Scans a row selected with revisionColumns
+func scanRevision(row scanner) (models.Revision, error) {
+	var (
+		rev           models.Revision
+		action        string
+		createdAt     int64
+		before, after sql.NullString
+	)
+	err := row.Scan(
+		&rev.ID,
+		&rev.ContactID,
+		&rev.OwnerKey,
+		&rev.Author,
+		&action,
+		&createdAt,
+		&before,
+		&after,
+	)
+	if err != nil {
+		return models.Revision{}, err
+	}
+	rev.Action = models.RevisionAction(action)
+	rev.CreatedAt = time.Unix(createdAt, 0)
+
+	if rev.Before, err = decodeSnapshot(before); err != nil {
+		return models.Revision{}, err
+	}
+	if rev.After, err = decodeSnapshot(after); err != nil {
+		return models.Revision{}, err
+	}
+	return rev, nil
+}
+
+// Snapshots are stored as JSON, nil snapshot is stored as NULL
+func encodeSnapshot(snapshot *models.ContactSnapshot) (sql.NullString, error) {
+	if snapshot == nil {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+func decodeSnapshot(data sql.NullString) (*models.ContactSnapshot, error) {
+	if !data.Valid {
+		return nil, nil
+	}
+	var snapshot models.ContactSnapshot
+	if err := json.Unmarshal([]byte(data.String), &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
@@ -0,0 +1,246 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"time"
+)
+
+const shareColumns = "id, owner_key, contact_id, group_id, grantee_email, access, created_at"
+
+// Ids of contacts shared with the user directly or through groups. Takes user's owner key twice
+const sharedContactIDs = `SELECT contact_id FROM contact_shares WHERE grantee_email = ` + userEmail + ` AND contact_id IS NOT NULL
+	UNION SELECT m.contact_id FROM contact_shares s JOIN contact_group_members m ON m.group_id = s.group_id
+	WHERE s.grantee_email = ` + userEmail
+
+// Builds condition restricting contacts to the user's ones and, when shared is set,
+// to the ones shared with the user. prefix is the alias of contacts table with a dot, if any
+func accessClause(prefix, ownerKey string, shared bool) (string, []any) {
+	if !shared {
+		return prefix + "owner_key = ?", []any{ownerKey}
+	}
+	return "(" + prefix + "owner_key = ? OR " + prefix + "id IN (" + sharedContactIDs + "))", []any{ownerKey, ownerKey, ownerKey}
+}
+
+// SaveShare grants access to owner's contact or group, or changes access granted before.
+// Fails with storage.ErrContactNotFound or storage.ErrGroupNotFound
+// if the owner has no such contact out of trash or group
+func (s *Storage) SaveShare(
+	ctx context.Context,
+	share models.Share,
+) (models.Share, error) {
+	const op = "sqlite.SaveShare"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Share{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var target, conflict string
+	var targetID int64
+	if share.ContactID != 0 {
+		var exists bool
+		err = tx.QueryRowContext(
+			ctx,
+			"SELECT EXISTS(SELECT 1 FROM contacts WHERE owner_key = ? AND id = ? AND deleted_at IS NULL)",
+			share.OwnerKey,
+			share.ContactID,
+		).Scan(&exists)
+		if err != nil {
+			return models.Share{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if !exists {
+			return models.Share{}, fmt.Errorf("%s: %w", op, storage.ErrContactNotFound)
+		}
+		target, conflict, targetID = "contact_id", "contact_id, grantee_email) WHERE contact_id IS NOT NULL", share.ContactID
+	} else {
+		if err = checkGroupOwner(ctx, tx, share.OwnerKey, share.GroupID); err != nil {
+			return models.Share{}, fmt.Errorf("%s: %w", op, err)
+		}
+		target, conflict, targetID = "group_id", "group_id, grantee_email) WHERE group_id IS NOT NULL", share.GroupID
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO contact_shares(owner_key, "+target+", grantee_email, access, created_at) VALUES(?, ?, ?, ?, ?)"+
+			" ON CONFLICT("+conflict+" DO UPDATE SET access = excluded.access",
+		share.OwnerKey,
+		targetID,
+		share.GranteeEmail,
+		share.Access,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		return models.Share{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	shares, err := queryShares(
+		ctx,
+		tx,
+		"SELECT "+shareColumns+" FROM contact_shares WHERE "+target+" = ? AND grantee_email = ?",
+		targetID,
+		share.GranteeEmail,
+	)
+	if err != nil {
+		return models.Share{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.Share{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return shares[0], nil
+}
+
+// DeleteShare revokes access granted by the owner
+func (s *Storage) DeleteShare(
+	ctx context.Context,
+	ownerKey string,
+	id int64,
+) error {
+	const op = "sqlite.DeleteShare"
+
+	res, err := s.db.ExecContext(ctx, "DELETE FROM contact_shares WHERE owner_key = ? AND id = ?", ownerKey, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrShareNotFound)
+	}
+	return nil
+}
+
+// Shares returns access granted by the owner, oldest first
+func (s *Storage) Shares(
+	ctx context.Context,
+	ownerKey string,
+) ([]models.Share, error) {
+	const op = "sqlite.Shares"
+
+	shares, err := queryShares(
+		ctx,
+		s.db,
+		"SELECT "+shareColumns+" FROM contact_shares WHERE owner_key = ? ORDER BY id",
+		ownerKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return shares, nil
+}
+
+// ContactAccess returns the contact and the user's access to it: the owner's one
+// for their own contacts, including the ones in trash, or the greatest access
+// shared with the user. Fails with storage.ErrContactNotFound if the user has no access
+func (s *Storage) ContactAccess(
+	ctx context.Context,
+	ownerKey string,
+	contactID int64,
+) (models.Contact, models.Access, error) {
+	const op = "sqlite.ContactAccess"
+
+	contacts, err := queryContacts(ctx, s.db, "SELECT "+contactColumns+" FROM contacts WHERE id = ?", contactID)
+	if err != nil {
+		return models.Contact{}, models.AccessNone, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(contacts) == 0 {
+		return models.Contact{}, models.AccessNone, fmt.Errorf("%s: %w", op, storage.ErrContactNotFound)
+	}
+	contact := contacts[0]
+	if contact.OwnerKey == ownerKey {
+		return contact, models.AccessOwner, nil
+	}
+	if !contact.DeletedAt.IsZero() {
+		return models.Contact{}, models.AccessNone, fmt.Errorf("%s: %w", op, storage.ErrContactNotFound)
+	}
+
+	var access sql.NullInt64
+	err = s.db.QueryRowContext(
+		ctx,
+		`SELECT MAX(access) FROM contact_shares WHERE grantee_email = `+userEmail+` AND (
+			contact_id = ? OR group_id IN (SELECT group_id FROM contact_group_members WHERE contact_id = ?)
+		)`,
+		ownerKey,
+		contactID,
+		contactID,
+	).Scan(&access)
+	if err != nil {
+		return models.Contact{}, models.AccessNone, fmt.Errorf("%s: %w", op, err)
+	}
+	if !access.Valid {
+		return models.Contact{}, models.AccessNone, fmt.Errorf("%s: %w", op, storage.ErrContactNotFound)
+	}
+	return contact, models.Access(access.Int64), nil
+}
+
+// ContactGrantees returns owner keys of the users the contact is shared with
+func (s *Storage) ContactGrantees(
+	ctx context.Context,
+	contactID int64,
+) ([]string, error) {
+	const op = "sqlite.ContactGrantees"
+
+	rows, err := s.db.QueryContext(ctx, "SELECT grantee_key FROM contact_grantees WHERE contact_id = ?", contactID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err = rows.Scan(&key); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		keys = append(keys, key)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return keys, nil
+}
+
+func queryShares(ctx context.Context, q querier, query string, args ...any) ([]models.Share, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var shares []models.Share
+	for rows.Next() {
+		var (
+			share     models.Share
+			contactID sql.NullInt64
+			groupID   sql.NullInt64
+			createdAt int64
+		)
+		err = rows.Scan(
+			&share.ID,
+			&share.OwnerKey,
+			&contactID,
+			&groupID,
+			&share.GranteeEmail,
+			&share.Access,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		share.ContactID = contactID.Int64
+		share.GroupID = groupID.Int64
+		share.CreatedAt = time.Unix(createdAt, 0)
+		shares = append(shares, share)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return shares, nil
+}
@@ -3,13 +3,26 @@ package sqlite
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/lib/translit"
 	"gRPC_ContactManagement_Service/internal/storage"
 	_ "github.com/mattn/go-sqlite3"
+	"strings"
+	"time"
+	"unicode"
 )
 
+const contactColumns = "id, owner_key, name, email, phone, created_at, deleted_at, version"
+
+var orderColumns = map[models.ContactOrder]string{
+	models.OrderByID:        "id",
+	models.OrderByName:      "name",
+	models.OrderByEmail:     "email",
+	models.OrderByCreatedAt: "created_at",
+	models.OrderByDeletedAt: "deleted_at",
+}
+
 type Storage struct {
 	db *sql.DB
 }
@@ -23,60 +36,114 @@ func New(storagePath string) (*Storage, error) {
 	return &Storage{db: db}, nil
 }
 
+// SaveContact saves contact with its emails and phones and the revision of its creation by author.
+// contact.Email and contact.Phone must be the primary values of the lists
 func (s *Storage) SaveContact(
 	ctx context.Context,
-	creatorEmail, name, email, phone string,
+	contact models.Contact,
+	author string,
 ) (uid int64, err error) {
 	const op = "sqlite.SaveContact"
 
-	stmt, err := s.db.Prepare("INSERT INTO contacts(creator_email, name, email, phone) VALUES(?, ?, ?, ?)")
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
+	defer tx.Rollback()
 
-	res, err := stmt.ExecContext(ctx, creatorEmail, name, email, phone)
+	id, err := saveContact(ctx, tx, contact, author)
 	if err != nil {
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+// Must be called in a transaction
+func saveContact(ctx context.Context, q querier, contact models.Contact, author string) (int64, error) {
+	res, err := q.ExecContext(
+		ctx,
+		"INSERT INTO contacts(owner_key, name, name_latin, email, phone, created_at) VALUES(?, ?, ?, ?, ?, ?)",
+		contact.OwnerKey,
+		contact.Name,
+		translit.Key(contact.Name),
+		contact.Email,
+		contact.Phone,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
 	id, err := res.LastInsertId()
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, err)
+		return 0, err
+	}
+
+	if err = saveEmails(ctx, q, id, contact.Emails); err != nil {
+		return 0, err
+	}
+	if err = savePhones(ctx, q, id, contact.Phones); err != nil {
+		return 0, err
+	}
+	if err = syncUniqueKeys(ctx, q, contact.OwnerKey, id); err != nil {
+		return 0, err
+	}
+
+	after, err := contactSnapshot(ctx, q, id)
+	if err != nil {
+		return 0, err
+	}
+	err = saveRevision(ctx, q, models.Revision{
+		ContactID: id,
+		OwnerKey:  contact.OwnerKey,
+		Author:    author,
+		Action:    models.RevisionCreate,
+		After:     after,
+	})
+	if err != nil {
+		return 0, err
 	}
 	return id, nil
 }
 
 func (s *Storage) Contact(
 	ctx context.Context,
-	creatorEmail, name, email, phone string,
+	ownerKey, name, email, phone string,
 ) (models.Contact, error) {
 	const op = "sqlite.Contact"
 
-	var query, param string
+	// contacts shared with the user are found too, user's own ones go first
+	owner, args := accessClause("", ownerKey, true)
+	var query string
 	if name != "" {
-		query = "SELECT name, email, phone FROM contacts WHERE creator_email = ? AND name = ?"
-		param = name
+		// names are compared by romanized key, so the search
+		// is case-insensitive and doesn't depend on the script
+		query = "SELECT " + contactColumns + " FROM contacts WHERE " + owner + " AND deleted_at IS NULL AND name_latin = ?"
+		args = append(args, translit.Key(name))
 	} else if email != "" {
-		query = "SELECT name, email, phone FROM contacts WHERE creator_email = ? AND email = ?"
-		param = email
+		// any of contact's emails matches, not only the primary one
+		query = "SELECT " + contactColumns + " FROM contacts WHERE " + owner + " AND deleted_at IS NULL AND id IN (SELECT contact_id FROM contact_emails WHERE email = ?)"
+		args = append(args, email)
 	} else {
-		query = "SELECT name, email, phone FROM contacts WHERE creator_email = ? AND phone = ?"
-		param = phone
+		query = "SELECT " + contactColumns + " FROM contacts WHERE " + owner + " AND deleted_at IS NULL AND id IN (SELECT contact_id FROM contact_phones WHERE phone = ?)"
+		args = append(args, phone)
 	}
-	stmt, err := s.db.Prepare(query)
+	query += " ORDER BY owner_key = ? DESC, id LIMIT 1"
+	args = append(args, ownerKey)
+
+	contacts, err := queryContacts(ctx, s.db, query, args...)
 	if err != nil {
 		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
 	}
-
-	row := stmt.QueryRowContext(ctx, creatorEmail, param)
-	var contact models.Contact
-	err = row.Scan(&contact.Name, &contact.Email, &contact.Phone)
-
-	if errors.Is(err, sql.ErrNoRows) {
+	if len(contacts) == 0 {
 		return models.Contact{}, fmt.Errorf("%s: %w", op, storage.ErrContactNotFound)
 	}
 
-	return contact, nil
+	return contacts[0], nil
 }
 
 func (s *Storage) ContactById(
@@ -84,43 +151,673 @@ func (s *Storage) ContactById(
 	id int64,
 ) (models.Contact, error) {
 	const op = "sqlite.ContactById"
-	query := "SELECT id FROM contacts WHERE id = ?"
-	stmt, err := s.db.Prepare(query)
+	query := "SELECT " + contactColumns + " FROM contacts WHERE id = ?"
+
+	contacts, err := queryContacts(ctx, s.db, query, id)
 	if err != nil {
 		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
 	}
+	if len(contacts) == 0 {
+		return models.Contact{}, fmt.Errorf("%s: %w", op, storage.ErrContactNotFound)
+	}
 
-	row := stmt.QueryRowContext(ctx, id)
-	var contact models.Contact
-	err = row.Scan(&contact.Name, &contact.Email, &contact.Phone)
+	return contacts[0], nil
+}
 
-	if errors.Is(err, sql.ErrNoRows) {
-		return models.Contact{}, fmt.Errorf("%s: %w", op, storage.ErrContactNotFound)
+// Contacts returns a page of owner's contacts using keyset pagination:
+// rows are filtered by (sort column, id) of the last contact of previous page,
+// so the cost of a page doesn't depend on how deep it is.
+func (s *Storage) Contacts(
+	ctx context.Context,
+	ownerKey string,
+	opts models.ListOptions,
+) ([]models.Contact, error) {
+	const op = "sqlite.Contacts"
+
+	column, ok := orderColumns[opts.OrderBy]
+	if !ok {
+		return nil, fmt.Errorf("%s: unknown order %d", op, opts.OrderBy)
+	}
+	cmp, dir := ">", "ASC"
+	if opts.Desc {
+		cmp, dir = "<", "DESC"
+	}
+
+	owner, args := accessClause("", ownerKey, opts.Filter.Shared)
+	query := "SELECT " + contactColumns + " FROM contacts WHERE " + owner
+	filter, filterArgs := filterClause("", ownerKey, opts.Filter)
+	query += filter
+	args = append(args, filterArgs...)
+	if opts.After != nil {
+		if column == "id" {
+			query += " AND id " + cmp + " ?"
+			args = append(args, opts.After.ID)
+		} else {
+			query += " AND (" + column + ", id) " + cmp + " (?, ?)"
+			args = append(args, orderValue(*opts.After, opts.OrderBy), opts.After.ID)
+		}
+	}
+	if column == "id" {
+		query += " ORDER BY id " + dir
+	} else {
+		query += " ORDER BY " + column + " " + dir + ", id " + dir
+	}
+	query += " LIMIT ?"
+	args = append(args, opts.Limit)
+
+	contacts, err := queryContacts(ctx, s.db, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return contacts, nil
+}
+
+// SearchContacts finds owner's contacts whose name, email or phone
+// contain tokens starting with the words of query. Best matches go first.
+func (s *Storage) SearchContacts(
+	ctx context.Context,
+	ownerKey, query string,
+	filter models.ContactFilter,
+	limit int,
+) ([]models.Contact, error) {
+	const op = "sqlite.SearchContacts"
+
+	match := ftsMatchQuery(query)
+	if match == "" {
+		return nil, nil
+	}
+
+	owner, ownerArgs := accessClause("c.", ownerKey, filter.Shared)
+	where, filterArgs := filterClause("c.", ownerKey, filter)
+	stmt := `
+		SELECT c.id, c.owner_key, c.name, c.email, c.phone, c.created_at, c.deleted_at, c.version
+		FROM contacts_fts
+		JOIN contacts c ON c.id = contacts_fts.rowid
+		WHERE contacts_fts MATCH ? AND ` + owner + where + `
+		ORDER BY contacts_fts.rank
+		LIMIT ?`
+
+	args := append([]any{match}, ownerArgs...)
+	args = append(args, filterArgs...)
+	args = append(args, limit)
+	contacts, err := queryContacts(ctx, s.db, stmt, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return contacts, nil
+}
+
+// UpdateContact changes the fields of owner's contact set in upd and saves the revision
+// of the change by author. upd.Email and upd.Phone must be the primary values
+// of upd.Emails and upd.Phones when the lists are replaced
+func (s *Storage) UpdateContact(
+	ctx context.Context,
+	ownerKey string,
+	id, version int64,
+	upd models.ContactUpdate,
+	author string,
+) (models.Contact, error) {
+	const op = "sqlite.UpdateContact"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
 	}
+	defer tx.Rollback()
 
-	if errors.Is(err, sql.ErrNoRows) {
+	if err = updateContact(ctx, tx, ownerKey, id, version, upd, author, models.RevisionUpdate); err != nil {
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	contact, err := s.ContactById(ctx, id)
+	if err != nil {
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return contact, nil
+}
+
+// RevertContact returns owner's contact to an earlier state: restores it from trash
+// if it is there and then applies upd, saving revisions of both changes by author.
+// Zero version reverts the contact regardless of its version
+func (s *Storage) RevertContact(
+	ctx context.Context,
+	ownerKey string,
+	id, version int64,
+	upd models.ContactUpdate,
+	author string,
+) (models.Contact, error) {
+	const op = "sqlite.RevertContact"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	contacts, err := queryContacts(
+		ctx,
+		tx,
+		"SELECT "+contactColumns+" FROM contacts WHERE owner_key = ? AND id = ?",
+		ownerKey,
+		id,
+	)
+	if err != nil {
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(contacts) == 0 {
 		return models.Contact{}, fmt.Errorf("%s: %w", op, storage.ErrContactNotFound)
 	}
+	current := contacts[0]
+	if version != 0 && version != current.Version {
+		return models.Contact{}, fmt.Errorf("%s: %w", op, storage.ErrVersionMismatch)
+	}
 
+	version = current.Version
+	if !current.DeletedAt.IsZero() {
+		if err = restoreContact(ctx, tx, ownerKey, id, version, author); err != nil {
+			return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+		}
+		version++
+	}
+	if err = updateContact(ctx, tx, ownerKey, id, version, upd, author, models.RevisionRevert); err != nil {
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	contact, err := s.ContactById(ctx, id)
+	if err != nil {
+		return models.Contact{}, fmt.Errorf("%s: %w", op, err)
+	}
 	return contact, nil
 }
 
+// Must be called in a transaction, action is the action of the saved revision
+func updateContact(
+	ctx context.Context,
+	q querier,
+	ownerKey string,
+	id, version int64,
+	upd models.ContactUpdate,
+	author string,
+	action models.RevisionAction,
+) error {
+	before, err := contactSnapshot(ctx, q, id)
+	if err != nil {
+		return err
+	}
+
+	res, err := q.ExecContext(
+		ctx,
+		"UPDATE contacts SET version = version + 1 WHERE owner_key = ? AND id = ? AND deleted_at IS NULL"+versionCond,
+		ownerKey,
+		id,
+		version,
+		version,
+	)
+	if err != nil {
+		return err
+	}
+	if err = expectChanged(ctx, q, res, ownerKey, id, false); err != nil {
+		return err
+	}
+
+	var (
+		set  []string
+		args []any
+	)
+	if upd.Name != nil {
+		set = append(set, "name = ?", "name_latin = ?")
+		args = append(args, *upd.Name, translit.Key(*upd.Name))
+	}
+	if upd.Email != nil {
+		set = append(set, "email = ?")
+		args = append(args, *upd.Email)
+	}
+	if upd.Phone != nil {
+		set = append(set, "phone = ?")
+		args = append(args, *upd.Phone)
+	}
+
+	if len(set) > 0 {
+		query := "UPDATE contacts SET " + strings.Join(set, ", ") + " WHERE id = ?"
+		args = append(args, id)
+		if _, err = q.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+
+	if upd.Emails != nil {
+		if err = replaceEmails(ctx, q, id, upd.Emails); err != nil {
+			return err
+		}
+	} else if upd.Email != nil {
+		_, err = q.ExecContext(ctx, "UPDATE contact_emails SET email = ? WHERE contact_id = ? AND is_primary = 1", *upd.Email, id)
+		if err != nil {
+			return err
+		}
+	}
+	if upd.Phones != nil {
+		if err = replacePhones(ctx, q, id, upd.Phones); err != nil {
+			return err
+		}
+	} else if upd.Phone != nil {
+		_, err = q.ExecContext(ctx, "UPDATE contact_phones SET phone = ? WHERE contact_id = ? AND is_primary = 1", *upd.Phone, id)
+		if err != nil {
+			return err
+		}
+	}
+	if err = syncUniqueKeys(ctx, q, ownerKey, id); err != nil {
+		return err
+	}
+
+	after, err := contactSnapshot(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	return saveRevision(ctx, q, models.Revision{
+		ContactID: id,
+		OwnerKey:  ownerKey,
+		Author:    author,
+		Action:    action,
+		Before:    before,
+		After:     after,
+	})
+}
+
+// DeleteContact moves owner's contact to trash and saves the revision of the deletion by author
 func (s *Storage) DeleteContact(
 	ctx context.Context,
-	creatorEmail string,
-	id int64,
+	ownerKey string,
+	id, version int64,
+	author string,
 ) error {
 	const op = "sqlite.DeleteContact"
 
-	stmt, err := s.db.Prepare("DELETE FROM contacts WHERE creator_email = ? AND id = ?")
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	defer tx.Rollback()
 
-	_, err = stmt.ExecContext(ctx, creatorEmail, id)
+	if err = deleteContact(ctx, tx, ownerKey, id, version, author); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Must be called in a transaction
+func deleteContact(ctx context.Context, q querier, ownerKey string, id, version int64, author string) error {
+	res, err := q.ExecContext(
+		ctx,
+		"UPDATE contacts SET deleted_at = ?, version = version + 1 WHERE owner_key = ? AND id = ? AND deleted_at IS NULL"+versionCond,
+		time.Now().Unix(),
+		ownerKey,
+		id,
+		version,
+		version,
+	)
+	if err != nil {
+		return err
+	}
+	if err = expectChanged(ctx, q, res, ownerKey, id, false); err != nil {
+		return err
+	}
+	if err = syncUniqueKeys(ctx, q, ownerKey, id); err != nil {
+		return err
+	}
+
+	// Moving to trash doesn't change the fields, so the state before is read after it
+	before, err := contactSnapshot(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	return saveRevision(ctx, q, models.Revision{
+		ContactID: id,
+		OwnerKey:  ownerKey,
+		Author:    author,
+		Action:    models.RevisionDelete,
+		Before:    before,
+	})
+}
+
+// RestoreContact moves owner's contact from trash back to the address book
+// and saves the revision of the restore by author.
+// Fails with *models.ContactExistsError if the contact's unique values
+// have been taken by another contact meanwhile
+func (s *Storage) RestoreContact(
+	ctx context.Context,
+	ownerKey string,
+	id, version int64,
+	author string,
+) error {
+	const op = "sqlite.RestoreContact"
+
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	defer tx.Rollback()
 
+	if err = restoreContact(ctx, tx, ownerKey, id, version, author); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
 	return nil
 }
+
+// Must be called in a transaction
+func restoreContact(ctx context.Context, q querier, ownerKey string, id, version int64, author string) error {
+	res, err := q.ExecContext(
+		ctx,
+		"UPDATE contacts SET deleted_at = NULL, version = version + 1 WHERE owner_key = ? AND id = ? AND deleted_at IS NOT NULL"+versionCond,
+		ownerKey,
+		id,
+		version,
+		version,
+	)
+	if err != nil {
+		return err
+	}
+	if err = expectChanged(ctx, q, res, ownerKey, id, true); err != nil {
+		return err
+	}
+	if err = syncUniqueKeys(ctx, q, ownerKey, id); err != nil {
+		return err
+	}
+
+	after, err := contactSnapshot(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	return saveRevision(ctx, q, models.Revision{
+		ContactID: id,
+		OwnerKey:  ownerKey,
+		Author:    author,
+		Action:    models.RevisionRestore,
+		After:     after,
+	})
+}
+
+// PurgeContact permanently removes owner's contact from trash
+// and saves the revision of the purge by author
+func (s *Storage) PurgeContact(
+	ctx context.Context,
+	ownerKey string,
+	id, version int64,
+	author string,
+) error {
+	const op = "sqlite.PurgeContact"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	before, err := contactSnapshot(ctx, tx, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	res, err := tx.ExecContext(
+		ctx,
+		"DELETE FROM contacts WHERE owner_key = ? AND id = ? AND deleted_at IS NOT NULL"+versionCond,
+		ownerKey,
+		id,
+		version,
+		version,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err = expectChanged(ctx, tx, res, ownerKey, id, true); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	err = saveRevision(ctx, tx, models.Revision{
+		ContactID: id,
+		OwnerKey:  ownerKey,
+		Author:    author,
+		Action:    models.RevisionPurge,
+		Before:    before,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// PurgeDeletedContacts permanently removes contacts of all owners
+// moved to trash before the given time and returns their number.
+// Revisions of the purges are saved by models.SystemAuthor
+func (s *Storage) PurgeDeletedContacts(
+	ctx context.Context,
+	before time.Time,
+) (int64, error) {
+	const op = "sqlite.PurgeDeletedContacts"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	const expired = " FROM contacts WHERE deleted_at IS NOT NULL AND deleted_at < ?"
+	contacts, err := queryContacts(ctx, tx, "SELECT "+contactColumns+expired, before.Unix())
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, contact := range contacts {
+		err = saveRevision(ctx, tx, models.Revision{
+			ContactID: contact.ID,
+			OwnerKey:  contact.OwnerKey,
+			Author:    models.SystemAuthor,
+			Action:    models.RevisionPurge,
+			Before:    snapshotOf(contact),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	res, err := tx.ExecContext(ctx, "DELETE"+expired, before.Unix())
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return n, nil
+}
+
+// Restricts change of a contact to the expected version.
+// Takes the version twice, version 0 allows any
+const versionCond = " AND (? = 0 OR version = ?)"
+
+// Returns nil if statement changed the contact. Otherwise tells apart
+// missing contact and contact of another version than expected.
+// trashed is whether the statement was looking for the contact in trash
+func expectChanged(ctx context.Context, q querier, res sql.Result, ownerKey string, id int64, trashed bool) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+
+	cond := " AND deleted_at IS NULL"
+	if trashed {
+		cond = " AND deleted_at IS NOT NULL"
+	}
+	var exists bool
+	err = q.QueryRowContext(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM contacts WHERE owner_key = ? AND id = ?"+cond+")",
+		ownerKey,
+		id,
+	).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return storage.ErrVersionMismatch
+	}
+	return storage.ErrContactNotFound
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// querier is either *sql.DB or *sql.Tx
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Runs query selecting contactColumns and loads emails and phones of found contacts
+func queryContacts(ctx context.Context, q querier, query string, args ...any) ([]models.Contact, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var contacts []models.Contact
+	for rows.Next() {
+		contact, err := scanContact(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		contacts = append(contacts, contact)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err = loadDetails(ctx, q, contacts); err != nil {
+		return nil, err
+	}
+	if err = loadOwners(ctx, q, contacts); err != nil {
+		return nil, err
+	}
+	return contacts, nil
+}
+
+// Scans a row selected with contactColumns
+func scanContact(row scanner) (models.Contact, error) {
+	var (
+		contact   models.Contact
+		createdAt int64
+		deletedAt sql.NullInt64
+	)
+	err := row.Scan(
+		&contact.ID,
+		&contact.OwnerKey,
+		&contact.Name,
+		&contact.Email,
+		&contact.Phone,
+		&createdAt,
+		&deletedAt,
+		&contact.Version,
+	)
+	if err != nil {
+		return models.Contact{}, err
+	}
+	contact.CreatedAt = time.Unix(createdAt, 0)
+	if deletedAt.Valid {
+		contact.DeletedAt = time.Unix(deletedAt.Int64, 0)
+	}
+	return contact, nil
+}
+
+// Builds conditions restricting contacts to the filter.
+// prefix is the alias of contacts table with a dot, if any
+func filterClause(prefix, ownerKey string, filter models.ContactFilter) (string, []any) {
+	var (
+		clause string
+		args   []any
+	)
+	if filter.Deleted {
+		clause += " AND " + prefix + "deleted_at IS NOT NULL"
+	} else {
+		clause += " AND " + prefix + "deleted_at IS NULL"
+	}
+	if filter.GroupID != 0 {
+		clause += " AND " + prefix + "id IN (SELECT contact_id FROM contact_group_members WHERE group_id = ?)"
+		args = append(args, filter.GroupID)
+	}
+	if filter.Tag != "" {
+		clause += " AND " + prefix + "id IN (" +
+			"SELECT ct.contact_id FROM contact_tags ct JOIN tags t ON t.id = ct.tag_id " +
+			"WHERE t.owner_key = ? AND t.name = ?)"
+		args = append(args, ownerKey, filter.Tag)
+	}
+	return clause, args
+}
+
+// Returns the value of contact's field the list is ordered by
+func orderValue(contact models.Contact, order models.ContactOrder) any {
+	switch order {
+	case models.OrderByName:
+		return contact.Name
+	case models.OrderByEmail:
+		return contact.Email
+	case models.OrderByCreatedAt:
+		return contact.CreatedAt.Unix()
+	case models.OrderByDeletedAt:
+		return contact.DeletedAt.Unix()
+	default:
+		return contact.ID
+	}
+}
+
+// Builds FTS5 query matching every word of user input as a prefix.
+// A word also matches by its romanized key, so "петр" finds "Petr" and vice versa.
+// Words are quoted, so FTS5 operators in the input are treated as text
+func ftsMatchQuery(query string) string {
+	var terms []string
+	for _, word := range strings.Fields(query) {
+		if !strings.ContainsFunc(word, func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsDigit(r)
+		}) {
+			continue
+		}
+		term := ftsPrefix(word)
+		if key := translit.Key(word); key != "" && key != strings.ToLower(word) {
+			term = "(" + term + " OR " + ftsPrefix(key) + ")"
+		}
+		terms = append(terms, term)
+	}
+	return strings.Join(terms, " AND ")
+}
+
+func ftsPrefix(word string) string {
+	return `"` + strings.ReplaceAll(word, `"`, `""`) + `"*`
+}
@@ -0,0 +1,77 @@
+// Package sqlitetest sets up storage for tests against real SQLite
+package sqlitetest
+
+import (
+	"database/sql"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/lib/translit"
+	"gRPC_ContactManagement_Service/internal/storage/sqlite"
+	"github.com/golang-migrate/migrate/v4"
+	migratesqlite "github.com/golang-migrate/migrate/v4/database/sqlite3"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	gosqlite3 "github.com/mattn/go-sqlite3"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// Driver of the connection migrations run on, it has the functions migrations use like the migrator's one
+const driverName = "sqlite3_test"
+
+var registerOnce sync.Once
+
+// New returns storage in a temporary file with all migrations applied and the path of the file.
+// Migrations need the sqlite_fts5 build tag
+func New(t *testing.T) (*sqlite.Storage, string) {
+	t.Helper()
+	registerOnce.Do(func() {
+		sql.Register(driverName, &gosqlite3.SQLiteDriver{ConnectHook: registerFunctions})
+	})
+
+	path := filepath.Join(t.TempDir(), "cm.db")
+	db, err := sql.Open(driverName, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	driver, err := migratesqlite.WithInstance(db, &migratesqlite.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := migrate.NewWithDatabaseInstance("file://"+migrationsPath(), "sqlite3", driver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = m.Up(); err != nil {
+		t.Fatal(err)
+	}
+
+	storage, err := sqlite.New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return storage, path
+}
+
+// Functions called by migrations. A new database has no data keyed by email,
+// so sso_user_id knows no users
+func registerFunctions(conn *gosqlite3.SQLiteConn) error {
+	err := conn.RegisterFunc("sso_user_id", func(string) (any, error) {
+		return nil, nil
+	}, true)
+	if err != nil {
+		return err
+	}
+	if err = conn.RegisterFunc("translit_key", translit.Key, true); err != nil {
+		return err
+	}
+	return conn.RegisterFunc("migration_error", func(msg string) (int, error) {
+		return 0, errors.New(msg)
+	}, false)
+}
+
+func migrationsPath() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..", "..", "migrations")
+}
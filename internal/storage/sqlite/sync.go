@@ -0,0 +1,175 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"time"
+)
+
+// ContactChanges returns up to limit changes of owner's contacts and contacts shared
+// with the owner made after the change since, ordered by their numbers, and the number
+// of the last change.
+// Zero since lists contacts out of trash only, otherwise contacts in trash and
+// purged ones are returned as tombstones. Fails with storage.ErrSyncExpired
+// if tombstones after since have been pruned or since is unknown
+func (s *Storage) ContactChanges(
+	ctx context.Context,
+	ownerKey string,
+	since int64,
+	limit int,
+) ([]models.ContactChange, int64, error) {
+	const op = "sqlite.ContactChanges"
+
+	// Read-only transaction keeps the changes and the sequence consistent
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var seq, minSeq int64
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT seq, min_seq FROM sync_sequences WHERE owner_key = ?",
+		ownerKey,
+	).Scan(&seq, &minSeq)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if since < 0 || since > seq || (since > 0 && since < minSeq) {
+		return nil, 0, fmt.Errorf("%s: %w", op, storage.ErrSyncExpired)
+	}
+
+	// Contacts shared with the user are numbered in the user's sequence too,
+	// the ones the user has lost access to are tombstones
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT contact_id, change_seq, deleted FROM (
+			SELECT ch.contact_id, ch.change_seq, c.id IS NULL OR c.deleted_at IS NOT NULL AS deleted
+			FROM contact_changes ch LEFT JOIN contacts c ON c.id = ch.contact_id AND ch.purged_at IS NULL
+			WHERE ch.owner_key = ? AND ch.change_seq > ?
+			UNION ALL
+			SELECT sc.contact_id, sc.change_seq, sc.revoked_at IS NOT NULL OR c.id IS NULL OR c.deleted_at IS NOT NULL
+			FROM shared_contact_changes sc LEFT JOIN contacts c ON c.id = sc.contact_id
+			WHERE sc.owner_key = ? AND sc.change_seq > ?
+		)
+		WHERE ? > 0 OR NOT deleted
+		ORDER BY change_seq LIMIT ?`,
+		ownerKey,
+		since,
+		ownerKey,
+		since,
+		since,
+		limit,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	var (
+		changes []models.ContactChange
+		liveIDs []any
+	)
+	for rows.Next() {
+		var change models.ContactChange
+		if err = rows.Scan(&change.Contact.ID, &change.Seq, &change.Deleted); err != nil {
+			rows.Close()
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
+		}
+		if !change.Deleted {
+			liveIDs = append(liveIDs, change.Contact.ID)
+		}
+		changes = append(changes, change)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(liveIDs) > 0 {
+		contacts, err := queryContacts(
+			ctx,
+			tx,
+			"SELECT "+contactColumns+" FROM contacts WHERE id IN "+placeholders(len(liveIDs)),
+			liveIDs...,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
+		}
+		byID := make(map[int64]models.Contact, len(contacts))
+		for _, contact := range contacts {
+			byID[contact.ID] = contact
+		}
+		for i := range changes {
+			if !changes[i].Deleted {
+				changes[i].Contact = byID[changes[i].Contact.ID]
+			}
+		}
+	}
+	return changes, seq, nil
+}
+
+// PurgeTombstones removes tombstones of contacts purged or no longer shared
+// before the given time and returns their number. Sync from before the removed tombstones expires
+func (s *Storage) PurgeTombstones(
+	ctx context.Context,
+	before time.Time,
+) (int64, error) {
+	const op = "sqlite.PurgeTombstones"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE sync_sequences SET min_seq = MAX(
+			min_seq,
+			COALESCE((
+				SELECT MAX(change_seq) FROM contact_changes ch
+				WHERE ch.owner_key = sync_sequences.owner_key AND ch.purged_at < ?
+			), 0),
+			COALESCE((
+				SELECT MAX(change_seq) FROM shared_contact_changes sc
+				WHERE sc.owner_key = sync_sequences.owner_key AND sc.revoked_at < ?
+			), 0)
+		)
+		WHERE owner_key IN (
+			SELECT owner_key FROM contact_changes WHERE purged_at < ?
+			UNION SELECT owner_key FROM shared_contact_changes WHERE revoked_at < ?
+		)`,
+		before.Unix(),
+		before.Unix(),
+		before.Unix(),
+		before.Unix(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var n int64
+	for _, stmt := range []string{
+		"DELETE FROM contact_changes WHERE purged_at < ?",
+		"DELETE FROM shared_contact_changes WHERE revoked_at < ?",
+	} {
+		res, err := tx.ExecContext(ctx, stmt, before.Unix())
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		deleted, err := res.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		n += deleted
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,229 @@
+//go:build sqlite_fts5
+
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"gRPC_ContactManagement_Service/internal/storage/sqlite"
+	"gRPC_ContactManagement_Service/internal/storage/sqlite/sqlitetest"
+	"testing"
+	"time"
+)
+
+var owner = models.UserKey(1)
+
+const (
+	ownerEmail   = "owner@example.com"
+	granteeEmail = "grantee@example.com"
+)
+
+func newStorage(t *testing.T) *sqlite.Storage {
+	t.Helper()
+	st, _ := sqlitetest.New(t)
+	ctx := context.Background()
+	if err := st.SaveUser(ctx, 1, ownerEmail); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.SaveUser(ctx, 2, granteeEmail); err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+// Returns contact of owner with email <name>@example.com
+func newContact(name string) models.Contact {
+	email := name + "@example.com"
+	return models.Contact{
+		OwnerKey: owner,
+		Name:     name,
+		Email:    email,
+		Emails:   []models.ContactEmail{{Email: email, Label: models.LabelOther, Primary: true}},
+	}
+}
+
+func saveContact(t *testing.T, st *sqlite.Storage, name string) int64 {
+	t.Helper()
+	id, err := st.SaveContact(context.Background(), newContact(name), owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+// Returns changes as "id" for live contacts and "-id" for tombstones
+func changesOf(t *testing.T, st *sqlite.Storage, ownerKey string, since int64) ([]string, int64) {
+	t.Helper()
+	changes, seq, err := st.ContactChanges(context.Background(), ownerKey, since, 100)
+	if err != nil {
+		t.Fatalf("ContactChanges(%d) error = %v", since, err)
+	}
+	res := make([]string, 0, len(changes))
+	last := since
+	for _, change := range changes {
+		if change.Seq <= last {
+			t.Errorf("change %d after %d", change.Seq, last)
+		}
+		last = change.Seq
+		if change.Deleted {
+			res = append(res, fmt.Sprint(-change.Contact.ID))
+		} else {
+			res = append(res, fmt.Sprint(change.Contact.ID))
+		}
+	}
+	if last > seq {
+		t.Errorf("change %d after sequence %d", last, seq)
+	}
+	return res, seq
+}
+
+func assertChanges(t *testing.T, got []string, want ...int64) {
+	t.Helper()
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("changes = %v, want %v", got, want)
+	}
+}
+
+func TestContactChanges(t *testing.T) {
+	st := newStorage(t)
+	ctx := context.Background()
+
+	ivan := saveContact(t, st, "ivan")
+	anna := saveContact(t, st, "anna")
+	changes, created := changesOf(t, st, owner, 0)
+	assertChanges(t, changes, ivan, anna)
+
+	name := "ivan petrov"
+	if _, err := st.UpdateContact(ctx, owner, ivan, 0, models.ContactUpdate{Name: &name}, owner); err != nil {
+		t.Fatal(err)
+	}
+	changes, updated := changesOf(t, st, owner, created)
+	assertChanges(t, changes, ivan)
+	if updated <= created {
+		t.Errorf("sequence %d after update, was %d", updated, created)
+	}
+
+	// contacts in trash are tombstones for clients that synced before
+	if err := st.DeleteContact(ctx, owner, anna, 0, owner); err != nil {
+		t.Fatal(err)
+	}
+	changes, deleted := changesOf(t, st, owner, updated)
+	assertChanges(t, changes, -anna)
+	changes, _ = changesOf(t, st, owner, 0)
+	assertChanges(t, changes, ivan)
+
+	if err := st.PurgeContact(ctx, owner, anna, 0, owner); err != nil {
+		t.Fatal(err)
+	}
+	changes, purged := changesOf(t, st, owner, deleted)
+	assertChanges(t, changes, -anna)
+	changes, _ = changesOf(t, st, owner, created)
+	assertChanges(t, changes, ivan, -anna)
+
+	// other owners have sequences of their own
+	changes, seq := changesOf(t, st, models.UserKey(2), 0)
+	assertChanges(t, changes)
+	if seq != 0 {
+		t.Errorf("sequence of another owner = %d, want 0", seq)
+	}
+
+	for _, since := range []int64{-1, purged + 1} {
+		if _, _, err := st.ContactChanges(ctx, owner, since, 100); !errors.Is(err, storage.ErrSyncExpired) {
+			t.Errorf("ContactChanges(%d) error = %v, want %v", since, err, storage.ErrSyncExpired)
+		}
+	}
+}
+
+func TestContactChangesOfSharedContacts(t *testing.T) {
+	st := newStorage(t)
+	ctx := context.Background()
+	grantee := models.UserKey(2)
+
+	ivan := saveContact(t, st, "ivan")
+	share, err := st.SaveShare(ctx, models.Share{OwnerKey: owner, ContactID: ivan, GranteeEmail: granteeEmail, Access: models.AccessRead})
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes, shared := changesOf(t, st, grantee, 0)
+	assertChanges(t, changes, ivan)
+
+	name := "ivan petrov"
+	if _, err = st.UpdateContact(ctx, owner, ivan, 0, models.ContactUpdate{Name: &name}, owner); err != nil {
+		t.Fatal(err)
+	}
+	changes, updated := changesOf(t, st, grantee, shared)
+	assertChanges(t, changes, ivan)
+
+	if err = st.DeleteShare(ctx, owner, share.ID); err != nil {
+		t.Fatal(err)
+	}
+	changes, _ = changesOf(t, st, grantee, updated)
+	assertChanges(t, changes, -ivan)
+	changes, _ = changesOf(t, st, grantee, 0)
+	assertChanges(t, changes)
+}
+
+func TestPurgeTombstones(t *testing.T) {
+	st := newStorage(t)
+	ctx := context.Background()
+	grantee := models.UserKey(2)
+
+	ivan := saveContact(t, st, "ivan")
+	anna := saveContact(t, st, "anna")
+	share, err := st.SaveShare(ctx, models.Share{OwnerKey: owner, ContactID: ivan, GranteeEmail: granteeEmail, Access: models.AccessRead})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, created := changesOf(t, st, owner, 0)
+	_, shared := changesOf(t, st, grantee, 0)
+
+	if err = st.DeleteContact(ctx, owner, anna, 0, owner); err != nil {
+		t.Fatal(err)
+	}
+	if err = st.PurgeContact(ctx, owner, anna, 0, owner); err != nil {
+		t.Fatal(err)
+	}
+	if err = st.DeleteShare(ctx, owner, share.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, purged := changesOf(t, st, owner, 0)
+	_, revoked := changesOf(t, st, grantee, 0)
+
+	// tombstones younger than retention stay
+	n, err := st.PurgeTombstones(ctx, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("PurgeTombstones() = %d, want 0", n)
+	}
+	changes, _ := changesOf(t, st, owner, created)
+	assertChanges(t, changes, -anna)
+
+	n, err = st.PurgeTombstones(ctx, time.Now().Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("PurgeTombstones() = %d, want 2", n)
+	}
+
+	// clients that synced before the removed tombstones have to sync from scratch
+	for _, tt := range []struct {
+		ownerKey string
+		since    int64
+	}{{owner, created}, {grantee, shared}} {
+		if _, _, err = st.ContactChanges(ctx, tt.ownerKey, tt.since, 100); !errors.Is(err, storage.ErrSyncExpired) {
+			t.Errorf("ContactChanges(%s, %d) error = %v, want %v", tt.ownerKey, tt.since, err, storage.ErrSyncExpired)
+		}
+	}
+	changes, _ = changesOf(t, st, owner, purged)
+	assertChanges(t, changes)
+	changes, _ = changesOf(t, st, owner, 0)
+	assertChanges(t, changes, ivan)
+	changes, _ = changesOf(t, st, grantee, revoked)
+	assertChanges(t, changes)
+}
@@ -0,0 +1,143 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"time"
+)
+
+// Current email of the user with the owner key, shares and book memberships are granted to it.
+// Team books have no email, so nothing is shared with them
+const userEmail = "(SELECT email FROM users WHERE owner_key = ?)"
+
+// SaveUser remembers the current email of the SSO user, whose data is stored under models.UserKey.
+// A new user gets a personal book. When the email has changed, shares and book memberships
+// granted to the old one follow it
+func (s *Storage) SaveUser(
+	ctx context.Context,
+	id int64,
+	email string,
+) error {
+	const op = "sqlite.SaveUser"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	key := models.UserKey(id)
+	var oldEmail string
+	err = tx.QueryRowContext(ctx, "SELECT email FROM users WHERE id = ?", id).Scan(&oldEmail)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO users(id, owner_key, email, updated_at) VALUES(?, ?, ?, ?)",
+			id,
+			key,
+			email,
+			time.Now().Unix(),
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		// users keyed by id in migration 15 have the book already
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO address_books(name, owner_key, personal, created_at) VALUES(?, ?, 1, ?)
+			ON CONFLICT(owner_key) DO NOTHING`,
+			personalBookName,
+			key,
+			time.Now().Unix(),
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	case err != nil:
+		return fmt.Errorf("%s: %w", op, err)
+	case oldEmail == email:
+		return nil
+	default:
+		// grants to the new email made before the user was seen with it are kept.
+		// Grants move before the email changes, so sync of shared contacts
+		// doesn't see them revoked and granted again
+		for _, stmt := range []string{
+			"UPDATE OR IGNORE contact_shares SET grantee_email = ? WHERE grantee_email = ?",
+			"UPDATE OR IGNORE address_book_members SET email = ? WHERE email = ?",
+		} {
+			if _, err = tx.ExecContext(ctx, stmt, email, oldEmail); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		_, err = tx.ExecContext(
+			ctx,
+			"UPDATE users SET email = ?, updated_at = ? WHERE id = ?",
+			email,
+			time.Now().Unix(),
+			id,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Fills AddressBookID of contacts and OwnerEmail of contacts of personal books
+func loadOwners(ctx context.Context, q querier, contacts []models.Contact) error {
+	keys := make(map[string]bool)
+	for _, c := range contacts {
+		keys[c.OwnerKey] = true
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	args := make([]any, 0, len(keys))
+	for key := range keys {
+		args = append(args, key)
+	}
+	rows, err := q.QueryContext(
+		ctx,
+		`SELECT b.owner_key, b.id, u.email FROM address_books b
+		LEFT JOIN users u ON u.owner_key = b.owner_key
+		WHERE b.owner_key IN `+placeholders(len(args)),
+		args...,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	books := make(map[string]int64, len(args))
+	emails := make(map[string]string, len(args))
+	for rows.Next() {
+		var (
+			key   string
+			id    int64
+			email sql.NullString
+		)
+		if err = rows.Scan(&key, &id, &email); err != nil {
+			return err
+		}
+		books[key] = id
+		emails[key] = email.String
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range contacts {
+		contacts[i].AddressBookID = books[contacts[i].OwnerKey]
+		contacts[i].OwnerEmail = emails[contacts[i].OwnerKey]
+	}
+	return nil
+}
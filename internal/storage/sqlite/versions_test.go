@@ -0,0 +1,77 @@
+//go:build sqlite_fts5
+
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"gRPC_ContactManagement_Service/internal/domain/models"
+	"gRPC_ContactManagement_Service/internal/storage"
+	"gRPC_ContactManagement_Service/internal/storage/sqlite"
+	"testing"
+)
+
+func versionOf(t *testing.T, st *sqlite.Storage, id int64) int64 {
+	t.Helper()
+	contact, _, err := st.ContactAccess(context.Background(), owner, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return contact.Version
+}
+
+func TestContactVersions(t *testing.T) {
+	st := newStorage(t)
+	ctx := context.Background()
+	ivan := saveContact(t, st, "ivan")
+	missing := ivan + 100
+	name := "ivan petrov"
+	// a version behind the current one is never zero, which would change the contact unconditionally
+	if err := st.AddContactTags(ctx, owner, ivan, 0, []string{"family"}); err != nil {
+		t.Fatal(err)
+	}
+
+	changes := []struct {
+		name  string
+		apply func(id, version int64) error
+	}{
+		{"update", func(id, version int64) error {
+			_, err := st.UpdateContact(ctx, owner, id, version, models.ContactUpdate{Name: &name}, owner)
+			return err
+		}},
+		{"add tags", func(id, version int64) error {
+			return st.AddContactTags(ctx, owner, id, version, []string{"friends"})
+		}},
+		{"remove tags", func(id, version int64) error {
+			return st.RemoveContactTags(ctx, owner, id, version, []string{"friends"})
+		}},
+		{"delete", func(id, version int64) error {
+			return st.DeleteContact(ctx, owner, id, version, owner)
+		}},
+		{"restore", func(id, version int64) error {
+			return st.RestoreContact(ctx, owner, id, version, owner)
+		}},
+	}
+	for _, change := range changes {
+		version := versionOf(t, st, ivan)
+		if err := change.apply(missing, version); !errors.Is(err, storage.ErrContactNotFound) {
+			t.Errorf("%s of missing contact: error = %v, want %v", change.name, err, storage.ErrContactNotFound)
+		}
+		for _, stale := range []int64{version - 1, version + 1} {
+			if err := change.apply(ivan, stale); !errors.Is(err, storage.ErrVersionMismatch) {
+				t.Errorf("%s with version %d of %d: error = %v, want %v", change.name, stale, version, err, storage.ErrVersionMismatch)
+			}
+		}
+		if err := change.apply(ivan, version); err != nil {
+			t.Fatalf("%s: %v", change.name, err)
+		}
+		if got := versionOf(t, st, ivan); got != version+1 {
+			t.Errorf("version after %s = %d, want %d", change.name, got, version+1)
+		}
+	}
+
+	// zero version changes the contact regardless of its version
+	if err := st.DeleteContact(ctx, owner, ivan, 0, owner); err != nil {
+		t.Errorf("unconditional delete: %v", err)
+	}
+}
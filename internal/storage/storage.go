@@ -1,8 +1,24 @@
 package storage
 
-import "errors"
+import (
+	"errors"
+)
 
 var (
-	ErrContactExists   = errors.New("contact exists")
-	ErrContactNotFound = errors.New("contact not found")
+	ErrContactExists    = errors.New("contact exists")
+	ErrContactNotFound  = errors.New("contact not found")
+	ErrGroupExists      = errors.New("group exists")
+	ErrGroupNotFound    = errors.New("group not found")
+	ErrRevisionNotFound = errors.New("revision not found")
+	ErrVersionMismatch  = errors.New("contact version mismatch")
+	ErrMergeNotFound    = errors.New("merge not found")
+	ErrShareNotFound    = errors.New("share not found")
+	ErrBookNotFound     = errors.New("address book not found")
+	ErrMemberNotFound   = errors.New("address book member not found")
+	// ErrLastBookOwner means the change would leave a team address book without owners
+	ErrLastBookOwner = errors.New("last owner of address book")
+	// ErrSyncExpired means changes after the requested one can no longer be listed
+	ErrSyncExpired = errors.New("sync expired")
+	// ErrBatchAborted is the error of batch items not applied because another item failed
+	ErrBatchAborted = errors.New("batch aborted")
 )
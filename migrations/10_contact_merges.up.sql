@@ -0,0 +1,15 @@
+-- Merges of duplicate contacts are kept so they can be undone.
+-- Id lists and survivor_before snapshot are JSON, survivor_version is the version right after merge
+CREATE TABLE IF NOT EXISTS contact_merges(
+    id INTEGER PRIMARY KEY,
+    creator_email TEXT NOT NULL,
+    survivor_id INTEGER NOT NULL,
+    merged_ids TEXT NOT NULL,
+    survivor_before TEXT NOT NULL,
+    survivor_version INTEGER NOT NULL,
+    added_tag_ids TEXT NOT NULL,
+    added_group_ids TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    undone_at INTEGER
+);
+CREATE INDEX IF NOT EXISTS idx_contact_merges_creator ON contact_merges(creator_email, id);
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS contacts_delete_unique_keys;
+
+DROP TABLE IF EXISTS contact_unique_keys;
+DROP TABLE IF EXISTS uniqueness_policies;
@@ -0,0 +1,21 @@
+-- Owner's policy of which contact fields must be unique among their contacts
+CREATE TABLE IF NOT EXISTS uniqueness_policies(
+    creator_email TEXT PRIMARY KEY,
+    unique_email INTEGER NOT NULL DEFAULT 0,
+    unique_phone INTEGER NOT NULL DEFAULT 0
+);
+
+-- Emails (lower-cased) and phones claimed by contacts out of trash under owner's policy.
+-- The unique index is what enforces the policy
+CREATE TABLE IF NOT EXISTS contact_unique_keys(
+    creator_email TEXT NOT NULL,
+    field TEXT NOT NULL,
+    value TEXT NOT NULL,
+    contact_id INTEGER NOT NULL REFERENCES contacts(id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_contact_unique_keys_value ON contact_unique_keys(creator_email, field, value);
+CREATE INDEX IF NOT EXISTS idx_contact_unique_keys_contact ON contact_unique_keys(contact_id);
+
+CREATE TRIGGER IF NOT EXISTS contacts_delete_unique_keys AFTER DELETE ON contacts BEGIN
+    DELETE FROM contact_unique_keys WHERE contact_id = old.id;
+END;
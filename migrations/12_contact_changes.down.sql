@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS contacts_changes_delete;
+DROP TRIGGER IF EXISTS contacts_changes_update;
+DROP TRIGGER IF EXISTS contacts_changes_insert;
+
+DROP TABLE IF EXISTS contact_changes;
+DROP TABLE IF EXISTS sync_sequences;
@@ -0,0 +1,44 @@
+-- Per-owner sequence of contact changes for incremental sync
+CREATE TABLE IF NOT EXISTS sync_sequences(
+    creator_email TEXT PRIMARY KEY,
+    seq INTEGER NOT NULL DEFAULT 0,
+    -- Tombstones up to min_seq have been pruned, older sync tokens require full resync
+    min_seq INTEGER NOT NULL DEFAULT 0
+);
+
+-- Number of the last change of every contact in its owner's sequence. Every change
+-- of a contact changes its version, so it is stamped with the next number.
+-- Rows of purged contacts stay as tombstones, purged_at is unix time in seconds
+CREATE TABLE IF NOT EXISTS contact_changes(
+    contact_id INTEGER PRIMARY KEY,
+    creator_email TEXT NOT NULL,
+    change_seq INTEGER NOT NULL,
+    purged_at INTEGER
+);
+CREATE INDEX IF NOT EXISTS idx_contact_changes_creator_seq ON contact_changes(creator_email, change_seq);
+
+INSERT INTO contact_changes(contact_id, creator_email, change_seq)
+SELECT id, creator_email, ROW_NUMBER() OVER (PARTITION BY creator_email ORDER BY id) FROM contacts;
+INSERT INTO sync_sequences(creator_email, seq)
+SELECT creator_email, MAX(change_seq) FROM contact_changes GROUP BY creator_email;
+
+CREATE TRIGGER IF NOT EXISTS contacts_changes_insert AFTER INSERT ON contacts BEGIN
+    INSERT INTO sync_sequences(creator_email, seq) VALUES (new.creator_email, 1)
+    ON CONFLICT(creator_email) DO UPDATE SET seq = seq + 1;
+    INSERT OR REPLACE INTO contact_changes(contact_id, creator_email, change_seq)
+    VALUES (new.id, new.creator_email, (SELECT seq FROM sync_sequences WHERE creator_email = new.creator_email));
+END;
+
+CREATE TRIGGER IF NOT EXISTS contacts_changes_update AFTER UPDATE OF version ON contacts BEGIN
+    UPDATE sync_sequences SET seq = seq + 1 WHERE creator_email = new.creator_email;
+    UPDATE contact_changes SET change_seq = (SELECT seq FROM sync_sequences WHERE creator_email = new.creator_email)
+    WHERE contact_id = new.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS contacts_changes_delete AFTER DELETE ON contacts BEGIN
+    UPDATE sync_sequences SET seq = seq + 1 WHERE creator_email = old.creator_email;
+    UPDATE contact_changes SET
+        change_seq = (SELECT seq FROM sync_sequences WHERE creator_email = old.creator_email),
+        purged_at = CAST(strftime('%s', 'now') AS INTEGER)
+    WHERE contact_id = old.id;
+END;
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS contact_groups_delete_shares;
+DROP TRIGGER IF EXISTS contacts_delete_shares;
+
+DROP TABLE IF EXISTS contact_shares;
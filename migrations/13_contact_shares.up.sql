@@ -0,0 +1,24 @@
+-- Access to owner's contact or to all contacts of owner's group granted to another user.
+-- Exactly one of contact_id and group_id is set. access is 1 for read-only and 2 for read-write
+CREATE TABLE IF NOT EXISTS contact_shares(
+    id INTEGER PRIMARY KEY,
+    owner_email TEXT NOT NULL,
+    contact_id INTEGER REFERENCES contacts(id),
+    group_id INTEGER REFERENCES contact_groups(id),
+    grantee_email TEXT NOT NULL,
+    access INTEGER NOT NULL,
+    created_at INTEGER NOT NULL,
+    CHECK ((contact_id IS NULL) != (group_id IS NULL))
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_contact_shares_contact ON contact_shares(contact_id, grantee_email) WHERE contact_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_contact_shares_group ON contact_shares(group_id, grantee_email) WHERE group_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_contact_shares_grantee ON contact_shares(grantee_email);
+CREATE INDEX IF NOT EXISTS idx_contact_shares_owner ON contact_shares(owner_email, id);
+
+CREATE TRIGGER IF NOT EXISTS contacts_delete_shares AFTER DELETE ON contacts BEGIN
+    DELETE FROM contact_shares WHERE contact_id = old.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS contact_groups_delete_shares AFTER DELETE ON contact_groups BEGIN
+    DELETE FROM contact_shares WHERE group_id = old.id;
+END;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_contacts_address_book;
+ALTER TABLE contacts DROP COLUMN address_book_id;
+
+DROP TABLE IF EXISTS address_book_members;
+DROP TABLE IF EXISTS address_books;
@@ -0,0 +1,35 @@
+-- Address book is a directory of contacts owned by a user (personal book) or by a team.
+-- owner_key is the creator_email contacts, groups, tags and other data of the book are stored under:
+-- owner's email for personal books and 'book:<id>' for team books
+CREATE TABLE IF NOT EXISTS address_books(
+    id INTEGER PRIMARY KEY,
+    name TEXT NOT NULL,
+    owner_key TEXT NOT NULL UNIQUE,
+    personal INTEGER NOT NULL DEFAULT 0,
+    created_at INTEGER NOT NULL
+);
+
+-- Members of team books. role is 1 for viewers, 2 for editors and 3 for owners.
+-- Personal books have no members, their owner is known from owner_key
+CREATE TABLE IF NOT EXISTS address_book_members(
+    address_book_id INTEGER NOT NULL REFERENCES address_books(id),
+    email TEXT NOT NULL,
+    role INTEGER NOT NULL,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (address_book_id, email)
+);
+CREATE INDEX IF NOT EXISTS idx_address_book_members_email ON address_book_members(email);
+
+ALTER TABLE contacts ADD COLUMN address_book_id INTEGER REFERENCES address_books(id);
+
+-- every user who has any data gets a personal book holding their existing contacts
+INSERT INTO address_books(name, owner_key, personal, created_at)
+SELECT 'Personal', creator_email, 1, CAST(strftime('%s', 'now') AS INTEGER)
+FROM (
+    SELECT creator_email FROM contacts
+    UNION SELECT creator_email FROM contact_groups
+    UNION SELECT creator_email FROM tags
+);
+
+UPDATE contacts SET address_book_id = (SELECT id FROM address_books WHERE owner_key = contacts.creator_email);
+CREATE INDEX IF NOT EXISTS idx_contacts_address_book ON contacts(address_book_id);
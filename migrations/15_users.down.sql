@@ -0,0 +1,42 @@
+-- data of users goes back under their current emails
+UPDATE contacts SET owner_key = (SELECT email FROM users u WHERE u.owner_key = contacts.owner_key)
+WHERE owner_key IN (SELECT owner_key FROM users);
+UPDATE contact_groups SET owner_key = (SELECT email FROM users u WHERE u.owner_key = contact_groups.owner_key)
+WHERE owner_key IN (SELECT owner_key FROM users);
+UPDATE tags SET owner_key = (SELECT email FROM users u WHERE u.owner_key = tags.owner_key)
+WHERE owner_key IN (SELECT owner_key FROM users);
+UPDATE contact_merges SET owner_key = (SELECT email FROM users u WHERE u.owner_key = contact_merges.owner_key)
+WHERE owner_key IN (SELECT owner_key FROM users);
+UPDATE uniqueness_policies SET owner_key = (SELECT email FROM users u WHERE u.owner_key = uniqueness_policies.owner_key)
+WHERE owner_key IN (SELECT owner_key FROM users);
+UPDATE contact_unique_keys SET owner_key = (SELECT email FROM users u WHERE u.owner_key = contact_unique_keys.owner_key)
+WHERE owner_key IN (SELECT owner_key FROM users);
+UPDATE sync_sequences SET owner_key = (SELECT email FROM users u WHERE u.owner_key = sync_sequences.owner_key)
+WHERE owner_key IN (SELECT owner_key FROM users);
+UPDATE contact_changes SET owner_key = (SELECT email FROM users u WHERE u.owner_key = contact_changes.owner_key)
+WHERE owner_key IN (SELECT owner_key FROM users);
+UPDATE contact_shares SET owner_key = (SELECT email FROM users u WHERE u.owner_key = contact_shares.owner_key)
+WHERE owner_key IN (SELECT owner_key FROM users);
+UPDATE address_books SET owner_key = (SELECT email FROM users u WHERE u.owner_key = address_books.owner_key)
+WHERE owner_key IN (SELECT owner_key FROM users);
+
+DROP TRIGGER IF EXISTS contact_revisions_no_update;
+UPDATE contact_revisions SET owner_key = (SELECT email FROM users u WHERE u.owner_key = contact_revisions.owner_key)
+WHERE owner_key IN (SELECT owner_key FROM users);
+CREATE TRIGGER IF NOT EXISTS contact_revisions_no_update BEFORE UPDATE ON contact_revisions BEGIN
+    SELECT RAISE(ABORT, 'contact revisions are immutable');
+END;
+
+ALTER TABLE contacts RENAME COLUMN owner_key TO creator_email;
+ALTER TABLE contact_groups RENAME COLUMN owner_key TO creator_email;
+ALTER TABLE tags RENAME COLUMN owner_key TO creator_email;
+ALTER TABLE contact_revisions RENAME COLUMN owner_key TO creator_email;
+ALTER TABLE contact_merges RENAME COLUMN owner_key TO creator_email;
+ALTER TABLE uniqueness_policies RENAME COLUMN owner_key TO creator_email;
+ALTER TABLE contact_unique_keys RENAME COLUMN owner_key TO creator_email;
+ALTER TABLE sync_sequences RENAME COLUMN owner_key TO creator_email;
+ALTER TABLE contact_changes RENAME COLUMN owner_key TO creator_email;
+ALTER TABLE contact_shares RENAME COLUMN owner_key TO owner_email;
+
+DROP INDEX IF EXISTS idx_users_email;
+DROP TABLE IF EXISTS users;
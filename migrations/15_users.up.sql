@@ -0,0 +1,55 @@
+-- SSO users of the service. Data of a user is stored under owner_key 'user:<id>' instead of
+-- the email, which may change. email is the current one, shares and book memberships are granted to it
+CREATE TABLE IF NOT EXISTS users(
+    id INTEGER PRIMARY KEY,
+    owner_key TEXT NOT NULL UNIQUE,
+    email TEXT NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_users_email ON users(email);
+
+-- columns hold owner keys of users and team books, not emails
+ALTER TABLE contacts RENAME COLUMN creator_email TO owner_key;
+ALTER TABLE contact_groups RENAME COLUMN creator_email TO owner_key;
+ALTER TABLE tags RENAME COLUMN creator_email TO owner_key;
+ALTER TABLE contact_revisions RENAME COLUMN creator_email TO owner_key;
+ALTER TABLE contact_merges RENAME COLUMN creator_email TO owner_key;
+ALTER TABLE uniqueness_policies RENAME COLUMN creator_email TO owner_key;
+ALTER TABLE contact_unique_keys RENAME COLUMN creator_email TO owner_key;
+ALTER TABLE sync_sequences RENAME COLUMN creator_email TO owner_key;
+ALTER TABLE contact_changes RENAME COLUMN creator_email TO owner_key;
+ALTER TABLE contact_shares RENAME COLUMN owner_email TO owner_key;
+
+-- Emails data is stored under are mapped to SSO users by sso_user_id, which the migrator
+-- defines from users exported from SSO (see --users). Data of emails missing from the export
+-- stays keyed by them and no user can reach it, the migrator reports how many emails there are.
+-- It has to be moved to 'user:<id>' keys by hand once the owners are known.
+-- Every owner of data has a personal book since migration 14
+SELECT migration_error('user ' || sso_user_id(owner_key) || ' owns data under several emails, merge it by hand')
+FROM address_books
+WHERE personal = 1 AND sso_user_id(owner_key) IS NOT NULL
+GROUP BY sso_user_id(owner_key)
+HAVING COUNT(*) > 1;
+
+INSERT INTO users(id, owner_key, email, updated_at)
+SELECT sso_user_id(owner_key), 'user:' || sso_user_id(owner_key), owner_key, CAST(strftime('%s', 'now') AS INTEGER)
+FROM address_books
+WHERE personal = 1 AND sso_user_id(owner_key) IS NOT NULL;
+
+UPDATE contacts SET owner_key = 'user:' || sso_user_id(owner_key) WHERE sso_user_id(owner_key) IS NOT NULL;
+UPDATE contact_groups SET owner_key = 'user:' || sso_user_id(owner_key) WHERE sso_user_id(owner_key) IS NOT NULL;
+UPDATE tags SET owner_key = 'user:' || sso_user_id(owner_key) WHERE sso_user_id(owner_key) IS NOT NULL;
+UPDATE contact_merges SET owner_key = 'user:' || sso_user_id(owner_key) WHERE sso_user_id(owner_key) IS NOT NULL;
+UPDATE uniqueness_policies SET owner_key = 'user:' || sso_user_id(owner_key) WHERE sso_user_id(owner_key) IS NOT NULL;
+UPDATE contact_unique_keys SET owner_key = 'user:' || sso_user_id(owner_key) WHERE sso_user_id(owner_key) IS NOT NULL;
+UPDATE sync_sequences SET owner_key = 'user:' || sso_user_id(owner_key) WHERE sso_user_id(owner_key) IS NOT NULL;
+UPDATE contact_changes SET owner_key = 'user:' || sso_user_id(owner_key) WHERE sso_user_id(owner_key) IS NOT NULL;
+UPDATE contact_shares SET owner_key = 'user:' || sso_user_id(owner_key) WHERE sso_user_id(owner_key) IS NOT NULL;
+UPDATE address_books SET owner_key = 'user:' || sso_user_id(owner_key) WHERE personal = 1 AND sso_user_id(owner_key) IS NOT NULL;
+
+-- revisions are immutable for everything but this migration
+DROP TRIGGER IF EXISTS contact_revisions_no_update;
+UPDATE contact_revisions SET owner_key = 'user:' || sso_user_id(owner_key) WHERE sso_user_id(owner_key) IS NOT NULL;
+CREATE TRIGGER IF NOT EXISTS contact_revisions_no_update BEFORE UPDATE ON contact_revisions BEGIN
+    SELECT RAISE(ABORT, 'contact revisions are immutable');
+END;
@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS users_shared_changes_update;
+DROP TRIGGER IF EXISTS users_shared_changes_insert;
+DROP TRIGGER IF EXISTS contact_group_members_shared_changes_delete;
+DROP TRIGGER IF EXISTS contact_shares_shared_changes_delete;
+DROP TRIGGER IF EXISTS contact_group_members_shared_changes_insert;
+DROP TRIGGER IF EXISTS contact_shares_shared_changes_insert;
+DROP TRIGGER IF EXISTS contacts_shared_changes_delete;
+DROP TRIGGER IF EXISTS contacts_shared_changes_update;
+
+DROP TABLE IF EXISTS shared_contact_changes;
+DROP VIEW IF EXISTS contact_grantees;
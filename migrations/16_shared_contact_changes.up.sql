@@ -0,0 +1,163 @@
+-- Users contacts are shared with, directly or through groups, by their owner keys
+CREATE VIEW IF NOT EXISTS contact_grantees AS
+SELECT u.owner_key AS grantee_key, s.contact_id
+FROM contact_shares s JOIN users u ON u.email = s.grantee_email
+WHERE s.contact_id IS NOT NULL
+UNION
+SELECT u.owner_key, m.contact_id
+FROM contact_shares s JOIN users u ON u.email = s.grantee_email JOIN contact_group_members m ON m.group_id = s.group_id;
+
+-- Number of the last change of every contact shared with a user in the user's sequence,
+-- so sync of the user's book gets changes of shared contacts along with the own ones.
+-- revoked_at is unix time in seconds the user lost access at, such rows are tombstones
+CREATE TABLE IF NOT EXISTS shared_contact_changes(
+    owner_key TEXT NOT NULL,
+    contact_id INTEGER NOT NULL,
+    change_seq INTEGER NOT NULL,
+    revoked_at INTEGER,
+    PRIMARY KEY (owner_key, contact_id)
+);
+CREATE INDEX IF NOT EXISTS idx_shared_contact_changes_owner_seq ON shared_contact_changes(owner_key, change_seq);
+CREATE INDEX IF NOT EXISTS idx_shared_contact_changes_contact ON shared_contact_changes(contact_id);
+
+-- Every trigger below stamps a set of (grantee, contact) pairs with the next numbers
+-- of the grantees' sequences: first the sequences are advanced by the number of pairs
+-- of each grantee, then the pairs get the numbers in between. The set is computed twice,
+-- so it must not depend on the stamped rows
+
+INSERT INTO sync_sequences(owner_key, seq)
+SELECT grantee_key, COUNT(*) FROM contact_grantees WHERE true GROUP BY grantee_key
+ON CONFLICT(owner_key) DO UPDATE SET seq = seq + excluded.seq;
+INSERT INTO shared_contact_changes(owner_key, contact_id, change_seq)
+SELECT grantee_key, contact_id, (SELECT seq FROM sync_sequences s WHERE s.owner_key = g.grantee_key)
+    - COUNT(*) OVER (PARTITION BY grantee_key) + ROW_NUMBER() OVER (PARTITION BY grantee_key ORDER BY contact_id)
+FROM contact_grantees g;
+
+-- A change of a contact is a change for everyone it is shared with
+CREATE TRIGGER IF NOT EXISTS contacts_shared_changes_update AFTER UPDATE OF version ON contacts BEGIN
+    INSERT INTO sync_sequences(owner_key, seq)
+    SELECT grantee_key, 1 FROM contact_grantees WHERE contact_id = new.id
+    ON CONFLICT(owner_key) DO UPDATE SET seq = seq + 1;
+    INSERT INTO shared_contact_changes(owner_key, contact_id, change_seq)
+    SELECT grantee_key, contact_id, (SELECT seq FROM sync_sequences s WHERE s.owner_key = g.grantee_key)
+    FROM contact_grantees g WHERE contact_id = new.id
+    ON CONFLICT(owner_key, contact_id) DO UPDATE SET change_seq = excluded.change_seq, revoked_at = NULL;
+END;
+
+CREATE TRIGGER IF NOT EXISTS contacts_shared_changes_delete AFTER DELETE ON contacts BEGIN
+    UPDATE sync_sequences SET seq = seq + 1
+    WHERE owner_key IN (SELECT owner_key FROM shared_contact_changes WHERE contact_id = old.id AND revoked_at IS NULL);
+    UPDATE shared_contact_changes SET
+        change_seq = (SELECT seq FROM sync_sequences s WHERE s.owner_key = shared_contact_changes.owner_key),
+        revoked_at = CAST(strftime('%s', 'now') AS INTEGER)
+    WHERE contact_id = old.id AND revoked_at IS NULL;
+END;
+
+-- Contacts the grantee of a new share or the members of a shared group become visible to
+CREATE TRIGGER IF NOT EXISTS contact_shares_shared_changes_insert AFTER INSERT ON contact_shares BEGIN
+    INSERT INTO sync_sequences(owner_key, seq)
+    SELECT g.grantee_key, COUNT(*) FROM contact_grantees g JOIN users u ON u.owner_key = g.grantee_key
+    WHERE u.email = new.grantee_email
+        AND g.contact_id IN (SELECT new.contact_id UNION SELECT contact_id FROM contact_group_members WHERE group_id = new.group_id)
+    GROUP BY g.grantee_key
+    ON CONFLICT(owner_key) DO UPDATE SET seq = seq + excluded.seq;
+    INSERT INTO shared_contact_changes(owner_key, contact_id, change_seq)
+    SELECT g.grantee_key, g.contact_id, (SELECT seq FROM sync_sequences s WHERE s.owner_key = g.grantee_key)
+        - COUNT(*) OVER (PARTITION BY g.grantee_key) + ROW_NUMBER() OVER (PARTITION BY g.grantee_key ORDER BY g.contact_id)
+    FROM contact_grantees g JOIN users u ON u.owner_key = g.grantee_key
+    WHERE u.email = new.grantee_email
+        AND g.contact_id IN (SELECT new.contact_id UNION SELECT contact_id FROM contact_group_members WHERE group_id = new.group_id)
+    ON CONFLICT(owner_key, contact_id) DO UPDATE SET change_seq = excluded.change_seq, revoked_at = NULL;
+END;
+
+CREATE TRIGGER IF NOT EXISTS contact_group_members_shared_changes_insert AFTER INSERT ON contact_group_members BEGIN
+    INSERT INTO sync_sequences(owner_key, seq)
+    SELECT grantee_key, 1 FROM contact_grantees WHERE contact_id = new.contact_id
+    ON CONFLICT(owner_key) DO UPDATE SET seq = seq + 1;
+    INSERT INTO shared_contact_changes(owner_key, contact_id, change_seq)
+    SELECT grantee_key, contact_id, (SELECT seq FROM sync_sequences s WHERE s.owner_key = g.grantee_key)
+    FROM contact_grantees g WHERE contact_id = new.contact_id
+    ON CONFLICT(owner_key, contact_id) DO UPDATE SET change_seq = excluded.change_seq, revoked_at = NULL;
+END;
+
+-- Contacts the grantee of a removed share or the members of a shared group no longer see
+CREATE TRIGGER IF NOT EXISTS contact_shares_shared_changes_delete AFTER DELETE ON contact_shares BEGIN
+    INSERT INTO sync_sequences(owner_key, seq)
+    SELECT sc.owner_key, COUNT(*) FROM shared_contact_changes sc JOIN users u ON u.owner_key = sc.owner_key
+    WHERE u.email = old.grantee_email AND sc.revoked_at IS NULL
+        AND NOT EXISTS (SELECT 1 FROM contact_grantees g WHERE g.grantee_key = sc.owner_key AND g.contact_id = sc.contact_id)
+    GROUP BY sc.owner_key
+    ON CONFLICT(owner_key) DO UPDATE SET seq = seq + excluded.seq;
+    INSERT INTO shared_contact_changes(owner_key, contact_id, change_seq, revoked_at)
+    SELECT sc.owner_key, sc.contact_id, (SELECT seq FROM sync_sequences s WHERE s.owner_key = sc.owner_key)
+        - COUNT(*) OVER (PARTITION BY sc.owner_key) + ROW_NUMBER() OVER (PARTITION BY sc.owner_key ORDER BY sc.contact_id), CAST(strftime('%s', 'now') AS INTEGER)
+    FROM shared_contact_changes sc JOIN users u ON u.owner_key = sc.owner_key
+    WHERE u.email = old.grantee_email AND sc.revoked_at IS NULL
+        AND NOT EXISTS (SELECT 1 FROM contact_grantees g WHERE g.grantee_key = sc.owner_key AND g.contact_id = sc.contact_id)
+    ON CONFLICT(owner_key, contact_id) DO UPDATE SET change_seq = excluded.change_seq, revoked_at = excluded.revoked_at;
+END;
+
+CREATE TRIGGER IF NOT EXISTS contact_group_members_shared_changes_delete AFTER DELETE ON contact_group_members BEGIN
+    UPDATE sync_sequences SET seq = seq + 1
+    WHERE owner_key IN (
+        SELECT owner_key FROM shared_contact_changes sc
+        WHERE contact_id = old.contact_id AND revoked_at IS NULL
+            AND NOT EXISTS (SELECT 1 FROM contact_grantees g WHERE g.grantee_key = sc.owner_key AND g.contact_id = sc.contact_id)
+    );
+    UPDATE shared_contact_changes SET
+        change_seq = (SELECT seq FROM sync_sequences s WHERE s.owner_key = shared_contact_changes.owner_key),
+        revoked_at = CAST(strftime('%s', 'now') AS INTEGER)
+    WHERE contact_id = old.contact_id AND revoked_at IS NULL
+        AND NOT EXISTS (
+            SELECT 1 FROM contact_grantees g
+            WHERE g.grantee_key = shared_contact_changes.owner_key AND g.contact_id = shared_contact_changes.contact_id
+        );
+END;
+
+-- A user seen for the first time or with a new email gets what is shared with the email
+-- and loses what was shared with the old one. Shares granted to the old email move
+-- to the new one before the email of the user changes, so they stay untouched
+CREATE TRIGGER IF NOT EXISTS users_shared_changes_insert AFTER INSERT ON users BEGIN
+    INSERT INTO sync_sequences(owner_key, seq)
+    SELECT grantee_key, COUNT(*) FROM contact_grantees WHERE grantee_key = new.owner_key GROUP BY grantee_key
+    ON CONFLICT(owner_key) DO UPDATE SET seq = seq + excluded.seq;
+    INSERT INTO shared_contact_changes(owner_key, contact_id, change_seq)
+    SELECT grantee_key, contact_id, (SELECT seq FROM sync_sequences s WHERE s.owner_key = g.grantee_key)
+        - COUNT(*) OVER (PARTITION BY grantee_key) + ROW_NUMBER() OVER (PARTITION BY grantee_key ORDER BY contact_id)
+    FROM contact_grantees g WHERE grantee_key = new.owner_key
+    ON CONFLICT(owner_key, contact_id) DO UPDATE SET change_seq = excluded.change_seq, revoked_at = NULL;
+END;
+
+CREATE TRIGGER IF NOT EXISTS users_shared_changes_update AFTER UPDATE OF email ON users BEGIN
+    INSERT INTO sync_sequences(owner_key, seq)
+    SELECT new.owner_key, COUNT(*) FROM shared_contact_changes sc
+    WHERE sc.owner_key = new.owner_key AND sc.revoked_at IS NULL
+        AND NOT EXISTS (SELECT 1 FROM contact_grantees g WHERE g.grantee_key = sc.owner_key AND g.contact_id = sc.contact_id)
+    HAVING COUNT(*) > 0
+    ON CONFLICT(owner_key) DO UPDATE SET seq = seq + excluded.seq;
+    INSERT INTO shared_contact_changes(owner_key, contact_id, change_seq, revoked_at)
+    SELECT sc.owner_key, sc.contact_id, (SELECT seq FROM sync_sequences s WHERE s.owner_key = sc.owner_key)
+        - COUNT(*) OVER (PARTITION BY sc.owner_key) + ROW_NUMBER() OVER (PARTITION BY sc.owner_key ORDER BY sc.contact_id), CAST(strftime('%s', 'now') AS INTEGER)
+    FROM shared_contact_changes sc
+    WHERE sc.owner_key = new.owner_key AND sc.revoked_at IS NULL
+        AND NOT EXISTS (SELECT 1 FROM contact_grantees g WHERE g.grantee_key = sc.owner_key AND g.contact_id = sc.contact_id)
+    ON CONFLICT(owner_key, contact_id) DO UPDATE SET change_seq = excluded.change_seq, revoked_at = excluded.revoked_at;
+
+    INSERT INTO sync_sequences(owner_key, seq)
+    SELECT grantee_key, COUNT(*) FROM contact_grantees g
+    WHERE grantee_key = new.owner_key AND NOT EXISTS (
+        SELECT 1 FROM shared_contact_changes sc
+        WHERE sc.owner_key = g.grantee_key AND sc.contact_id = g.contact_id AND sc.revoked_at IS NULL
+    )
+    GROUP BY grantee_key
+    ON CONFLICT(owner_key) DO UPDATE SET seq = seq + excluded.seq;
+    INSERT INTO shared_contact_changes(owner_key, contact_id, change_seq)
+    SELECT grantee_key, contact_id, (SELECT seq FROM sync_sequences s WHERE s.owner_key = g.grantee_key)
+        - COUNT(*) OVER (PARTITION BY grantee_key) + ROW_NUMBER() OVER (PARTITION BY grantee_key ORDER BY contact_id)
+    FROM contact_grantees g
+    WHERE grantee_key = new.owner_key AND NOT EXISTS (
+        SELECT 1 FROM shared_contact_changes sc
+        WHERE sc.owner_key = g.grantee_key AND sc.contact_id = g.contact_id AND sc.revoked_at IS NULL
+    )
+    ON CONFLICT(owner_key, contact_id) DO UPDATE SET change_seq = excluded.change_seq, revoked_at = NULL;
+END;
@@ -0,0 +1,3 @@
+ALTER TABLE contacts ADD COLUMN address_book_id INTEGER REFERENCES address_books(id);
+UPDATE contacts SET address_book_id = (SELECT id FROM address_books WHERE owner_key = contacts.owner_key);
+CREATE INDEX IF NOT EXISTS idx_contacts_address_book ON contacts(address_book_id);
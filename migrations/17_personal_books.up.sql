@@ -0,0 +1,9 @@
+-- Personal books are created once with their users, users seen before get theirs here
+INSERT INTO address_books(name, owner_key, personal, created_at)
+SELECT 'Personal', owner_key, 1, CAST(strftime('%s', 'now') AS INTEGER)
+FROM users
+WHERE owner_key NOT IN (SELECT owner_key FROM address_books);
+
+-- the book of a contact is the one with the contact's owner_key
+DROP INDEX IF EXISTS idx_contacts_address_book;
+ALTER TABLE contacts DROP COLUMN address_book_id;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_contacts_creator_created_at;
+DROP INDEX IF EXISTS idx_contacts_creator_email;
+DROP INDEX IF EXISTS idx_contacts_creator_name;
+
+ALTER TABLE contacts DROP COLUMN created_at;
@@ -0,0 +1,6 @@
+ALTER TABLE contacts ADD COLUMN created_at INTEGER NOT NULL DEFAULT 0;
+UPDATE contacts SET created_at = CAST(strftime('%s', 'now') AS INTEGER);
+
+CREATE INDEX IF NOT EXISTS idx_contacts_creator_name ON contacts(creator_email, name, id);
+CREATE INDEX IF NOT EXISTS idx_contacts_creator_email ON contacts(creator_email, email, id);
+CREATE INDEX IF NOT EXISTS idx_contacts_creator_created_at ON contacts(creator_email, created_at, id);
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS contacts_fts_update;
+DROP TRIGGER IF EXISTS contacts_fts_delete;
+DROP TRIGGER IF EXISTS contacts_fts_insert;
+
+DROP TABLE IF EXISTS contacts_fts;
@@ -0,0 +1,35 @@
+DROP TRIGGER IF EXISTS contacts_fts_update;
+DROP TRIGGER IF EXISTS contacts_fts_delete;
+DROP TRIGGER IF EXISTS contacts_fts_insert;
+DROP TABLE IF EXISTS contacts_fts;
+
+CREATE VIRTUAL TABLE IF NOT EXISTS contacts_fts USING fts5(
+    name,
+    email,
+    phone,
+    content = 'contacts',
+    content_rowid = 'id',
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER IF NOT EXISTS contacts_fts_insert AFTER INSERT ON contacts BEGIN
+    INSERT INTO contacts_fts(rowid, name, email, phone)
+    VALUES (new.id, new.name, new.email, new.phone);
+END;
+
+CREATE TRIGGER IF NOT EXISTS contacts_fts_delete AFTER DELETE ON contacts BEGIN
+    INSERT INTO contacts_fts(contacts_fts, rowid, name, email, phone)
+    VALUES ('delete', old.id, old.name, old.email, old.phone);
+END;
+
+CREATE TRIGGER IF NOT EXISTS contacts_fts_update AFTER UPDATE ON contacts BEGIN
+    INSERT INTO contacts_fts(contacts_fts, rowid, name, email, phone)
+    VALUES ('delete', old.id, old.name, old.email, old.phone);
+    INSERT INTO contacts_fts(rowid, name, email, phone)
+    VALUES (new.id, new.name, new.email, new.phone);
+END;
+
+INSERT INTO contacts_fts(contacts_fts) VALUES ('rebuild');
+
+DROP INDEX IF EXISTS idx_contacts_creator_name_latin;
+ALTER TABLE contacts DROP COLUMN name_latin;
@@ -0,0 +1,41 @@
+-- name_latin is a romanized search key of name, see translit.Key
+ALTER TABLE contacts ADD COLUMN name_latin TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_contacts_creator_name_latin ON contacts(creator_email, name_latin);
+
+-- translit_key is translit.Key, the migrator defines it
+UPDATE contacts SET name_latin = translit_key(name) WHERE name != '';
+
+DROP TRIGGER IF EXISTS contacts_fts_update;
+DROP TRIGGER IF EXISTS contacts_fts_delete;
+DROP TRIGGER IF EXISTS contacts_fts_insert;
+DROP TABLE IF EXISTS contacts_fts;
+
+CREATE VIRTUAL TABLE IF NOT EXISTS contacts_fts USING fts5(
+    name,
+    name_latin,
+    email,
+    phone,
+    content = 'contacts',
+    content_rowid = 'id',
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER IF NOT EXISTS contacts_fts_insert AFTER INSERT ON contacts BEGIN
+    INSERT INTO contacts_fts(rowid, name, name_latin, email, phone)
+    VALUES (new.id, new.name, new.name_latin, new.email, new.phone);
+END;
+
+CREATE TRIGGER IF NOT EXISTS contacts_fts_delete AFTER DELETE ON contacts BEGIN
+    INSERT INTO contacts_fts(contacts_fts, rowid, name, name_latin, email, phone)
+    VALUES ('delete', old.id, old.name, old.name_latin, old.email, old.phone);
+END;
+
+CREATE TRIGGER IF NOT EXISTS contacts_fts_update AFTER UPDATE ON contacts BEGIN
+    INSERT INTO contacts_fts(contacts_fts, rowid, name, name_latin, email, phone)
+    VALUES ('delete', old.id, old.name, old.name_latin, old.email, old.phone);
+    INSERT INTO contacts_fts(rowid, name, name_latin, email, phone)
+    VALUES (new.id, new.name, new.name_latin, new.email, new.phone);
+END;
+
+INSERT INTO contacts_fts(contacts_fts) VALUES ('rebuild');
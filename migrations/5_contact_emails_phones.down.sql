@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS contacts_delete_children;
+
+DROP TABLE IF EXISTS contact_phones;
+DROP TABLE IF EXISTS contact_emails;
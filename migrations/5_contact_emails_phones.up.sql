@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS contact_emails(
+    id INTEGER PRIMARY KEY,
+    contact_id INTEGER NOT NULL REFERENCES contacts(id),
+    email TEXT NOT NULL,
+    label TEXT NOT NULL DEFAULT 'other',
+    is_primary INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_contact_emails_contact ON contact_emails(contact_id);
+CREATE INDEX IF NOT EXISTS idx_contact_emails_email ON contact_emails(email);
+
+CREATE TABLE IF NOT EXISTS contact_phones(
+    id INTEGER PRIMARY KEY,
+    contact_id INTEGER NOT NULL REFERENCES contacts(id),
+    phone TEXT NOT NULL,
+    label TEXT NOT NULL DEFAULT 'other',
+    is_primary INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_contact_phones_contact ON contact_phones(contact_id);
+CREATE INDEX IF NOT EXISTS idx_contact_phones_phone ON contact_phones(phone);
+
+-- contacts.email and contacts.phone keep the primary values
+INSERT INTO contact_emails(contact_id, email, label, is_primary)
+SELECT id, email, 'other', 1 FROM contacts;
+INSERT INTO contact_phones(contact_id, phone, label, is_primary)
+SELECT id, phone, 'other', 1 FROM contacts;
+
+CREATE TRIGGER IF NOT EXISTS contacts_delete_children AFTER DELETE ON contacts BEGIN
+    DELETE FROM contact_emails WHERE contact_id = old.id;
+    DELETE FROM contact_phones WHERE contact_id = old.id;
+END;
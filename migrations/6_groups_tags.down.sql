@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS contact_groups_delete_members;
+DROP TRIGGER IF EXISTS contacts_delete_memberships;
+
+DROP TABLE IF EXISTS contact_tags;
+DROP TABLE IF EXISTS tags;
+DROP TABLE IF EXISTS contact_group_members;
+DROP TABLE IF EXISTS contact_groups;
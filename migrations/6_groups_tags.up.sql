@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS contact_groups(
+    id INTEGER PRIMARY KEY,
+    creator_email TEXT NOT NULL,
+    name TEXT NOT NULL,
+    created_at INTEGER NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_contact_groups_creator_name ON contact_groups(creator_email, name);
+
+CREATE TABLE IF NOT EXISTS contact_group_members(
+    group_id INTEGER NOT NULL REFERENCES contact_groups(id),
+    contact_id INTEGER NOT NULL REFERENCES contacts(id),
+    PRIMARY KEY (group_id, contact_id)
+);
+CREATE INDEX IF NOT EXISTS idx_contact_group_members_contact ON contact_group_members(contact_id);
+
+CREATE TABLE IF NOT EXISTS tags(
+    id INTEGER PRIMARY KEY,
+    creator_email TEXT NOT NULL,
+    name TEXT NOT NULL COLLATE NOCASE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_creator_name ON tags(creator_email, name);
+
+CREATE TABLE IF NOT EXISTS contact_tags(
+    contact_id INTEGER NOT NULL REFERENCES contacts(id),
+    tag_id INTEGER NOT NULL REFERENCES tags(id),
+    PRIMARY KEY (contact_id, tag_id)
+);
+CREATE INDEX IF NOT EXISTS idx_contact_tags_tag ON contact_tags(tag_id);
+
+CREATE TRIGGER IF NOT EXISTS contacts_delete_memberships AFTER DELETE ON contacts BEGIN
+    DELETE FROM contact_group_members WHERE contact_id = old.id;
+    DELETE FROM contact_tags WHERE contact_id = old.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS contact_groups_delete_members AFTER DELETE ON contact_groups BEGIN
+    DELETE FROM contact_group_members WHERE group_id = old.id;
+END;
@@ -0,0 +1,8 @@
+-- Contacts in trash would come back without deleted_at, so they have to be restored or purged first
+SELECT migration_error(COUNT(*) || ' contacts are in trash, restore or purge them before rolling back')
+FROM contacts
+WHERE deleted_at IS NOT NULL
+HAVING COUNT(*) > 0;
+
+DROP INDEX IF EXISTS idx_contacts_creator_deleted_at;
+ALTER TABLE contacts DROP COLUMN deleted_at;
@@ -0,0 +1,4 @@
+-- Deleted contacts stay in trash until purged, deleted_at is unix time in seconds
+ALTER TABLE contacts ADD COLUMN deleted_at INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_contacts_creator_deleted_at ON contacts(creator_email, deleted_at, id) WHERE deleted_at IS NOT NULL;
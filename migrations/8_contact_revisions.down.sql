@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS contact_revisions_no_delete;
+DROP TRIGGER IF EXISTS contact_revisions_no_update;
+
+DROP TABLE IF EXISTS contact_revisions;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS contact_revisions(
+    id INTEGER PRIMARY KEY,
+    contact_id INTEGER NOT NULL,
+    creator_email TEXT NOT NULL,
+    author TEXT NOT NULL,
+    action TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    before TEXT,
+    after TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_contact_revisions_creator_contact ON contact_revisions(creator_email, contact_id, id);
+
+CREATE TRIGGER IF NOT EXISTS contact_revisions_no_update BEFORE UPDATE ON contact_revisions BEGIN
+    SELECT RAISE(ABORT, 'contact revisions are immutable');
+END;
+
+CREATE TRIGGER IF NOT EXISTS contact_revisions_no_delete BEFORE DELETE ON contact_revisions BEGIN
+    SELECT RAISE(ABORT, 'contact revisions are immutable');
+END;
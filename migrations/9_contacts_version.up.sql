@@ -0,0 +1,2 @@
+-- version is incremented by every change of a contact and used for optimistic concurrency
+ALTER TABLE contacts ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
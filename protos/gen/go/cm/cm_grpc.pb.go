@@ -0,0 +1,1757 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: cm/cm.proto
+
+package cmv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ContactManager_CreateContact_FullMethodName           = "/ContactManager.ContactManager/CreateContact"
+	ContactManager_GetContactByName_FullMethodName        = "/ContactManager.ContactManager/GetContactByName"
+	ContactManager_GetContactByEmail_FullMethodName       = "/ContactManager.ContactManager/GetContactByEmail"
+	ContactManager_GetContactByPhone_FullMethodName       = "/ContactManager.ContactManager/GetContactByPhone"
+	ContactManager_DeleteContact_FullMethodName           = "/ContactManager.ContactManager/DeleteContact"
+	ContactManager_UpdateContact_FullMethodName           = "/ContactManager.ContactManager/UpdateContact"
+	ContactManager_ListContacts_FullMethodName            = "/ContactManager.ContactManager/ListContacts"
+	ContactManager_SearchContacts_FullMethodName          = "/ContactManager.ContactManager/SearchContacts"
+	ContactManager_FuzzySearchContacts_FullMethodName     = "/ContactManager.ContactManager/FuzzySearchContacts"
+	ContactManager_CreateGroup_FullMethodName             = "/ContactManager.ContactManager/CreateGroup"
+	ContactManager_RenameGroup_FullMethodName             = "/ContactManager.ContactManager/RenameGroup"
+	ContactManager_DeleteGroup_FullMethodName             = "/ContactManager.ContactManager/DeleteGroup"
+	ContactManager_ListGroups_FullMethodName              = "/ContactManager.ContactManager/ListGroups"
+	ContactManager_AddGroupMembers_FullMethodName         = "/ContactManager.ContactManager/AddGroupMembers"
+	ContactManager_RemoveGroupMembers_FullMethodName      = "/ContactManager.ContactManager/RemoveGroupMembers"
+	ContactManager_AddContactTags_FullMethodName          = "/ContactManager.ContactManager/AddContactTags"
+	ContactManager_RemoveContactTags_FullMethodName       = "/ContactManager.ContactManager/RemoveContactTags"
+	ContactManager_ExportContacts_FullMethodName          = "/ContactManager.ContactManager/ExportContacts"
+	ContactManager_ImportContacts_FullMethodName          = "/ContactManager.ContactManager/ImportContacts"
+	ContactManager_ExportContactsCSV_FullMethodName       = "/ContactManager.ContactManager/ExportContactsCSV"
+	ContactManager_ImportContactsCSV_FullMethodName       = "/ContactManager.ContactManager/ImportContactsCSV"
+	ContactManager_ListDeletedContacts_FullMethodName     = "/ContactManager.ContactManager/ListDeletedContacts"
+	ContactManager_RestoreContact_FullMethodName          = "/ContactManager.ContactManager/RestoreContact"
+	ContactManager_PurgeContact_FullMethodName            = "/ContactManager.ContactManager/PurgeContact"
+	ContactManager_ListContactRevisions_FullMethodName    = "/ContactManager.ContactManager/ListContactRevisions"
+	ContactManager_RestoreContactRevision_FullMethodName  = "/ContactManager.ContactManager/RestoreContactRevision"
+	ContactManager_FindDuplicates_FullMethodName          = "/ContactManager.ContactManager/FindDuplicates"
+	ContactManager_MergeContacts_FullMethodName           = "/ContactManager.ContactManager/MergeContacts"
+	ContactManager_UndoMergeContacts_FullMethodName       = "/ContactManager.ContactManager/UndoMergeContacts"
+	ContactManager_GetUniquenessPolicy_FullMethodName     = "/ContactManager.ContactManager/GetUniquenessPolicy"
+	ContactManager_SetUniquenessPolicy_FullMethodName     = "/ContactManager.ContactManager/SetUniquenessPolicy"
+	ContactManager_BatchCreateContacts_FullMethodName     = "/ContactManager.ContactManager/BatchCreateContacts"
+	ContactManager_BatchDeleteContacts_FullMethodName     = "/ContactManager.ContactManager/BatchDeleteContacts"
+	ContactManager_WatchContacts_FullMethodName           = "/ContactManager.ContactManager/WatchContacts"
+	ContactManager_SyncContacts_FullMethodName            = "/ContactManager.ContactManager/SyncContacts"
+	ContactManager_ShareContact_FullMethodName            = "/ContactManager.ContactManager/ShareContact"
+	ContactManager_ShareGroup_FullMethodName              = "/ContactManager.ContactManager/ShareGroup"
+	ContactManager_RemoveShare_FullMethodName             = "/ContactManager.ContactManager/RemoveShare"
+	ContactManager_ListShares_FullMethodName              = "/ContactManager.ContactManager/ListShares"
+	ContactManager_CreateAddressBook_FullMethodName       = "/ContactManager.ContactManager/CreateAddressBook"
+	ContactManager_ListAddressBooks_FullMethodName        = "/ContactManager.ContactManager/ListAddressBooks"
+	ContactManager_ListAddressBookMembers_FullMethodName  = "/ContactManager.ContactManager/ListAddressBookMembers"
+	ContactManager_SetAddressBookMember_FullMethodName    = "/ContactManager.ContactManager/SetAddressBookMember"
+	ContactManager_RemoveAddressBookMember_FullMethodName = "/ContactManager.ContactManager/RemoveAddressBookMember"
+)
+
+// ContactManagerClient is the client API for ContactManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Every request has address_book_id, the address book the request works with.
+// The caller's personal book is used when it is 0.
+type ContactManagerClient interface {
+	CreateContact(ctx context.Context, in *CreateContactRequest, opts ...grpc.CallOption) (*CreateContactResponse, error)
+	GetContactByName(ctx context.Context, in *GetContactByNameRequest, opts ...grpc.CallOption) (*GetContactResponse, error)
+	GetContactByEmail(ctx context.Context, in *GetContactByEmailRequest, opts ...grpc.CallOption) (*GetContactResponse, error)
+	GetContactByPhone(ctx context.Context, in *GetContactByPhoneRequest, opts ...grpc.CallOption) (*GetContactResponse, error)
+	DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error)
+	UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error)
+	ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
+	SearchContacts(ctx context.Context, in *SearchContactsRequest, opts ...grpc.CallOption) (*SearchContactsResponse, error)
+	FuzzySearchContacts(ctx context.Context, in *FuzzySearchContactsRequest, opts ...grpc.CallOption) (*FuzzySearchContactsResponse, error)
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+	RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...grpc.CallOption) (*RenameGroupResponse, error)
+	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error)
+	RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error)
+	AddContactTags(ctx context.Context, in *AddContactTagsRequest, opts ...grpc.CallOption) (*AddContactTagsResponse, error)
+	RemoveContactTags(ctx context.Context, in *RemoveContactTagsRequest, opts ...grpc.CallOption) (*RemoveContactTagsResponse, error)
+	ExportContacts(ctx context.Context, in *ExportContactsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportContactsResponse], error)
+	ImportContacts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportContactsRequest, ImportContactsResponse], error)
+	ExportContactsCSV(ctx context.Context, in *ExportContactsCSVRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportContactsCSVResponse], error)
+	ImportContactsCSV(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportContactsCSVRequest, ImportContactsResponse], error)
+	ListDeletedContacts(ctx context.Context, in *ListDeletedContactsRequest, opts ...grpc.CallOption) (*ListDeletedContactsResponse, error)
+	RestoreContact(ctx context.Context, in *RestoreContactRequest, opts ...grpc.CallOption) (*RestoreContactResponse, error)
+	PurgeContact(ctx context.Context, in *PurgeContactRequest, opts ...grpc.CallOption) (*PurgeContactResponse, error)
+	ListContactRevisions(ctx context.Context, in *ListContactRevisionsRequest, opts ...grpc.CallOption) (*ListContactRevisionsResponse, error)
+	RestoreContactRevision(ctx context.Context, in *RestoreContactRevisionRequest, opts ...grpc.CallOption) (*RestoreContactRevisionResponse, error)
+	FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error)
+	MergeContacts(ctx context.Context, in *MergeContactsRequest, opts ...grpc.CallOption) (*MergeContactsResponse, error)
+	UndoMergeContacts(ctx context.Context, in *UndoMergeContactsRequest, opts ...grpc.CallOption) (*UndoMergeContactsResponse, error)
+	GetUniquenessPolicy(ctx context.Context, in *GetUniquenessPolicyRequest, opts ...grpc.CallOption) (*GetUniquenessPolicyResponse, error)
+	SetUniquenessPolicy(ctx context.Context, in *SetUniquenessPolicyRequest, opts ...grpc.CallOption) (*SetUniquenessPolicyResponse, error)
+	BatchCreateContacts(ctx context.Context, in *BatchCreateContactsRequest, opts ...grpc.CallOption) (*BatchCreateContactsResponse, error)
+	BatchDeleteContacts(ctx context.Context, in *BatchDeleteContactsRequest, opts ...grpc.CallOption) (*BatchDeleteContactsResponse, error)
+	WatchContacts(ctx context.Context, in *WatchContactsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ContactEvent], error)
+	SyncContacts(ctx context.Context, in *SyncContactsRequest, opts ...grpc.CallOption) (*SyncContactsResponse, error)
+	ShareContact(ctx context.Context, in *ShareContactRequest, opts ...grpc.CallOption) (*ShareContactResponse, error)
+	ShareGroup(ctx context.Context, in *ShareGroupRequest, opts ...grpc.CallOption) (*ShareGroupResponse, error)
+	RemoveShare(ctx context.Context, in *RemoveShareRequest, opts ...grpc.CallOption) (*RemoveShareResponse, error)
+	ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error)
+	CreateAddressBook(ctx context.Context, in *CreateAddressBookRequest, opts ...grpc.CallOption) (*CreateAddressBookResponse, error)
+	ListAddressBooks(ctx context.Context, in *ListAddressBooksRequest, opts ...grpc.CallOption) (*ListAddressBooksResponse, error)
+	ListAddressBookMembers(ctx context.Context, in *ListAddressBookMembersRequest, opts ...grpc.CallOption) (*ListAddressBookMembersResponse, error)
+	SetAddressBookMember(ctx context.Context, in *SetAddressBookMemberRequest, opts ...grpc.CallOption) (*SetAddressBookMemberResponse, error)
+	RemoveAddressBookMember(ctx context.Context, in *RemoveAddressBookMemberRequest, opts ...grpc.CallOption) (*RemoveAddressBookMemberResponse, error)
+}
+
+type contactManagerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewContactManagerClient(cc grpc.ClientConnInterface) ContactManagerClient {
+	return &contactManagerClient{cc}
+}
+
+func (c *contactManagerClient) CreateContact(ctx context.Context, in *CreateContactRequest, opts ...grpc.CallOption) (*CreateContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateContactResponse)
+	err := c.cc.Invoke(ctx, ContactManager_CreateContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) GetContactByName(ctx context.Context, in *GetContactByNameRequest, opts ...grpc.CallOption) (*GetContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContactResponse)
+	err := c.cc.Invoke(ctx, ContactManager_GetContactByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) GetContactByEmail(ctx context.Context, in *GetContactByEmailRequest, opts ...grpc.CallOption) (*GetContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContactResponse)
+	err := c.cc.Invoke(ctx, ContactManager_GetContactByEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) GetContactByPhone(ctx context.Context, in *GetContactByPhoneRequest, opts ...grpc.CallOption) (*GetContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContactResponse)
+	err := c.cc.Invoke(ctx, ContactManager_GetContactByPhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteContactResponse)
+	err := c.cc.Invoke(ctx, ContactManager_DeleteContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateContactResponse)
+	err := c.cc.Invoke(ctx, ContactManager_UpdateContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContactsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_ListContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) SearchContacts(ctx context.Context, in *SearchContactsRequest, opts ...grpc.CallOption) (*SearchContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchContactsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_SearchContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) FuzzySearchContacts(ctx context.Context, in *FuzzySearchContactsRequest, opts ...grpc.CallOption) (*FuzzySearchContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FuzzySearchContactsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_FuzzySearchContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupResponse)
+	err := c.cc.Invoke(ctx, ContactManager_CreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...grpc.CallOption) (*RenameGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameGroupResponse)
+	err := c.cc.Invoke(ctx, ContactManager_RenameGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupResponse)
+	err := c.cc.Invoke(ctx, ContactManager_DeleteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_ListGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGroupMembersResponse)
+	err := c.cc.Invoke(ctx, ContactManager_AddGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGroupMembersResponse)
+	err := c.cc.Invoke(ctx, ContactManager_RemoveGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) AddContactTags(ctx context.Context, in *AddContactTagsRequest, opts ...grpc.CallOption) (*AddContactTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddContactTagsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_AddContactTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) RemoveContactTags(ctx context.Context, in *RemoveContactTagsRequest, opts ...grpc.CallOption) (*RemoveContactTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveContactTagsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_RemoveContactTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) ExportContacts(ctx context.Context, in *ExportContactsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportContactsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ContactManager_ServiceDesc.Streams[0], ContactManager_ExportContacts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportContactsRequest, ExportContactsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContactManager_ExportContactsClient = grpc.ServerStreamingClient[ExportContactsResponse]
+
+func (c *contactManagerClient) ImportContacts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportContactsRequest, ImportContactsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ContactManager_ServiceDesc.Streams[1], ContactManager_ImportContacts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportContactsRequest, ImportContactsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContactManager_ImportContactsClient = grpc.ClientStreamingClient[ImportContactsRequest, ImportContactsResponse]
+
+func (c *contactManagerClient) ExportContactsCSV(ctx context.Context, in *ExportContactsCSVRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportContactsCSVResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ContactManager_ServiceDesc.Streams[2], ContactManager_ExportContactsCSV_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportContactsCSVRequest, ExportContactsCSVResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContactManager_ExportContactsCSVClient = grpc.ServerStreamingClient[ExportContactsCSVResponse]
+
+func (c *contactManagerClient) ImportContactsCSV(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportContactsCSVRequest, ImportContactsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ContactManager_ServiceDesc.Streams[3], ContactManager_ImportContactsCSV_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportContactsCSVRequest, ImportContactsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContactManager_ImportContactsCSVClient = grpc.ClientStreamingClient[ImportContactsCSVRequest, ImportContactsResponse]
+
+func (c *contactManagerClient) ListDeletedContacts(ctx context.Context, in *ListDeletedContactsRequest, opts ...grpc.CallOption) (*ListDeletedContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedContactsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_ListDeletedContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) RestoreContact(ctx context.Context, in *RestoreContactRequest, opts ...grpc.CallOption) (*RestoreContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreContactResponse)
+	err := c.cc.Invoke(ctx, ContactManager_RestoreContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) PurgeContact(ctx context.Context, in *PurgeContactRequest, opts ...grpc.CallOption) (*PurgeContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeContactResponse)
+	err := c.cc.Invoke(ctx, ContactManager_PurgeContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) ListContactRevisions(ctx context.Context, in *ListContactRevisionsRequest, opts ...grpc.CallOption) (*ListContactRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContactRevisionsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_ListContactRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) RestoreContactRevision(ctx context.Context, in *RestoreContactRevisionRequest, opts ...grpc.CallOption) (*RestoreContactRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreContactRevisionResponse)
+	err := c.cc.Invoke(ctx, ContactManager_RestoreContactRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindDuplicatesResponse)
+	err := c.cc.Invoke(ctx, ContactManager_FindDuplicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) MergeContacts(ctx context.Context, in *MergeContactsRequest, opts ...grpc.CallOption) (*MergeContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeContactsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_MergeContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) UndoMergeContacts(ctx context.Context, in *UndoMergeContactsRequest, opts ...grpc.CallOption) (*UndoMergeContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndoMergeContactsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_UndoMergeContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) GetUniquenessPolicy(ctx context.Context, in *GetUniquenessPolicyRequest, opts ...grpc.CallOption) (*GetUniquenessPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUniquenessPolicyResponse)
+	err := c.cc.Invoke(ctx, ContactManager_GetUniquenessPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) SetUniquenessPolicy(ctx context.Context, in *SetUniquenessPolicyRequest, opts ...grpc.CallOption) (*SetUniquenessPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUniquenessPolicyResponse)
+	err := c.cc.Invoke(ctx, ContactManager_SetUniquenessPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) BatchCreateContacts(ctx context.Context, in *BatchCreateContactsRequest, opts ...grpc.CallOption) (*BatchCreateContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateContactsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_BatchCreateContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) BatchDeleteContacts(ctx context.Context, in *BatchDeleteContactsRequest, opts ...grpc.CallOption) (*BatchDeleteContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteContactsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_BatchDeleteContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) WatchContacts(ctx context.Context, in *WatchContactsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ContactEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ContactManager_ServiceDesc.Streams[4], ContactManager_WatchContacts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchContactsRequest, ContactEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContactManager_WatchContactsClient = grpc.ServerStreamingClient[ContactEvent]
+
+func (c *contactManagerClient) SyncContacts(ctx context.Context, in *SyncContactsRequest, opts ...grpc.CallOption) (*SyncContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncContactsResponse)
+	err := c.cc.Invoke(ctx, ContactManager_SyncContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) ShareContact(ctx context.Context, in *ShareContactRequest, opts ...grpc.CallOption) (*ShareContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareContactResponse)
+	err := c.cc.Invoke(ctx, ContactManager_ShareContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) ShareGroup(ctx context.Context, in *ShareGroupRequest, opts ...grpc.CallOption) (*ShareGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareGroupResponse)
+	err := c.cc.Invoke(ctx, ContactManager_ShareGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) RemoveShare(ctx context.Context, in *RemoveShareRequest, opts ...grpc.CallOption) (*RemoveShareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveShareResponse)
+	err := c.cc.Invoke(ctx, ContactManager_RemoveShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSharesResponse)
+	err := c.cc.Invoke(ctx, ContactManager_ListShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) CreateAddressBook(ctx context.Context, in *CreateAddressBookRequest, opts ...grpc.CallOption) (*CreateAddressBookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAddressBookResponse)
+	err := c.cc.Invoke(ctx, ContactManager_CreateAddressBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) ListAddressBooks(ctx context.Context, in *ListAddressBooksRequest, opts ...grpc.CallOption) (*ListAddressBooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAddressBooksResponse)
+	err := c.cc.Invoke(ctx, ContactManager_ListAddressBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) ListAddressBookMembers(ctx context.Context, in *ListAddressBookMembersRequest, opts ...grpc.CallOption) (*ListAddressBookMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAddressBookMembersResponse)
+	err := c.cc.Invoke(ctx, ContactManager_ListAddressBookMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) SetAddressBookMember(ctx context.Context, in *SetAddressBookMemberRequest, opts ...grpc.CallOption) (*SetAddressBookMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAddressBookMemberResponse)
+	err := c.cc.Invoke(ctx, ContactManager_SetAddressBookMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactManagerClient) RemoveAddressBookMember(ctx context.Context, in *RemoveAddressBookMemberRequest, opts ...grpc.CallOption) (*RemoveAddressBookMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveAddressBookMemberResponse)
+	err := c.cc.Invoke(ctx, ContactManager_RemoveAddressBookMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ContactManagerServer is the server API for ContactManager service.
+// All implementations must embed UnimplementedContactManagerServer
+// for forward compatibility.
+//
+// Every request has address_book_id, the address book the request works with.
+// The caller's personal book is used when it is 0.
+type ContactManagerServer interface {
+	CreateContact(context.Context, *CreateContactRequest) (*CreateContactResponse, error)
+	GetContactByName(context.Context, *GetContactByNameRequest) (*GetContactResponse, error)
+	GetContactByEmail(context.Context, *GetContactByEmailRequest) (*GetContactResponse, error)
+	GetContactByPhone(context.Context, *GetContactByPhoneRequest) (*GetContactResponse, error)
+	DeleteContact(context.Context, *DeleteContactRequest) (*DeleteContactResponse, error)
+	UpdateContact(context.Context, *UpdateContactRequest) (*UpdateContactResponse, error)
+	ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error)
+	SearchContacts(context.Context, *SearchContactsRequest) (*SearchContactsResponse, error)
+	FuzzySearchContacts(context.Context, *FuzzySearchContactsRequest) (*FuzzySearchContactsResponse, error)
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	RenameGroup(context.Context, *RenameGroupRequest) (*RenameGroupResponse, error)
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersResponse, error)
+	RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersResponse, error)
+	AddContactTags(context.Context, *AddContactTagsRequest) (*AddContactTagsResponse, error)
+	RemoveContactTags(context.Context, *RemoveContactTagsRequest) (*RemoveContactTagsResponse, error)
+	ExportContacts(*ExportContactsRequest, grpc.ServerStreamingServer[ExportContactsResponse]) error
+	ImportContacts(grpc.ClientStreamingServer[ImportContactsRequest, ImportContactsResponse]) error
+	ExportContactsCSV(*ExportContactsCSVRequest, grpc.ServerStreamingServer[ExportContactsCSVResponse]) error
+	ImportContactsCSV(grpc.ClientStreamingServer[ImportContactsCSVRequest, ImportContactsResponse]) error
+	ListDeletedContacts(context.Context, *ListDeletedContactsRequest) (*ListDeletedContactsResponse, error)
+	RestoreContact(context.Context, *RestoreContactRequest) (*RestoreContactResponse, error)
+	PurgeContact(context.Context, *PurgeContactRequest) (*PurgeContactResponse, error)
+	ListContactRevisions(context.Context, *ListContactRevisionsRequest) (*ListContactRevisionsResponse, error)
+	RestoreContactRevision(context.Context, *RestoreContactRevisionRequest) (*RestoreContactRevisionResponse, error)
+	FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error)
+	MergeContacts(context.Context, *MergeContactsRequest) (*MergeContactsResponse, error)
+	UndoMergeContacts(context.Context, *UndoMergeContactsRequest) (*UndoMergeContactsResponse, error)
+	GetUniquenessPolicy(context.Context, *GetUniquenessPolicyRequest) (*GetUniquenessPolicyResponse, error)
+	SetUniquenessPolicy(context.Context, *SetUniquenessPolicyRequest) (*SetUniquenessPolicyResponse, error)
+	BatchCreateContacts(context.Context, *BatchCreateContactsRequest) (*BatchCreateContactsResponse, error)
+	BatchDeleteContacts(context.Context, *BatchDeleteContactsRequest) (*BatchDeleteContactsResponse, error)
+	WatchContacts(*WatchContactsRequest, grpc.ServerStreamingServer[ContactEvent]) error
+	SyncContacts(context.Context, *SyncContactsRequest) (*SyncContactsResponse, error)
+	ShareContact(context.Context, *ShareContactRequest) (*ShareContactResponse, error)
+	ShareGroup(context.Context, *ShareGroupRequest) (*ShareGroupResponse, error)
+	RemoveShare(context.Context, *RemoveShareRequest) (*RemoveShareResponse, error)
+	ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error)
+	CreateAddressBook(context.Context, *CreateAddressBookRequest) (*CreateAddressBookResponse, error)
+	ListAddressBooks(context.Context, *ListAddressBooksRequest) (*ListAddressBooksResponse, error)
+	ListAddressBookMembers(context.Context, *ListAddressBookMembersRequest) (*ListAddressBookMembersResponse, error)
+	SetAddressBookMember(context.Context, *SetAddressBookMemberRequest) (*SetAddressBookMemberResponse, error)
+	RemoveAddressBookMember(context.Context, *RemoveAddressBookMemberRequest) (*RemoveAddressBookMemberResponse, error)
+	mustEmbedUnimplementedContactManagerServer()
+}
+
+// UnimplementedContactManagerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedContactManagerServer struct{}
+
+func (UnimplementedContactManagerServer) CreateContact(context.Context, *CreateContactRequest) (*CreateContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContact not implemented")
+}
+func (UnimplementedContactManagerServer) GetContactByName(context.Context, *GetContactByNameRequest) (*GetContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContactByName not implemented")
+}
+func (UnimplementedContactManagerServer) GetContactByEmail(context.Context, *GetContactByEmailRequest) (*GetContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContactByEmail not implemented")
+}
+func (UnimplementedContactManagerServer) GetContactByPhone(context.Context, *GetContactByPhoneRequest) (*GetContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContactByPhone not implemented")
+}
+func (UnimplementedContactManagerServer) DeleteContact(context.Context, *DeleteContactRequest) (*DeleteContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContact not implemented")
+}
+func (UnimplementedContactManagerServer) UpdateContact(context.Context, *UpdateContactRequest) (*UpdateContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContact not implemented")
+}
+func (UnimplementedContactManagerServer) ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContacts not implemented")
+}
+func (UnimplementedContactManagerServer) SearchContacts(context.Context, *SearchContactsRequest) (*SearchContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchContacts not implemented")
+}
+func (UnimplementedContactManagerServer) FuzzySearchContacts(context.Context, *FuzzySearchContactsRequest) (*FuzzySearchContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuzzySearchContacts not implemented")
+}
+func (UnimplementedContactManagerServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedContactManagerServer) RenameGroup(context.Context, *RenameGroupRequest) (*RenameGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameGroup not implemented")
+}
+func (UnimplementedContactManagerServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedContactManagerServer) ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (UnimplementedContactManagerServer) AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupMembers not implemented")
+}
+func (UnimplementedContactManagerServer) RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMembers not implemented")
+}
+func (UnimplementedContactManagerServer) AddContactTags(context.Context, *AddContactTagsRequest) (*AddContactTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddContactTags not implemented")
+}
+func (UnimplementedContactManagerServer) RemoveContactTags(context.Context, *RemoveContactTagsRequest) (*RemoveContactTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContactTags not implemented")
+}
+func (UnimplementedContactManagerServer) ExportContacts(*ExportContactsRequest, grpc.ServerStreamingServer[ExportContactsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportContacts not implemented")
+}
+func (UnimplementedContactManagerServer) ImportContacts(grpc.ClientStreamingServer[ImportContactsRequest, ImportContactsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportContacts not implemented")
+}
+func (UnimplementedContactManagerServer) ExportContactsCSV(*ExportContactsCSVRequest, grpc.ServerStreamingServer[ExportContactsCSVResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportContactsCSV not implemented")
+}
+func (UnimplementedContactManagerServer) ImportContactsCSV(grpc.ClientStreamingServer[ImportContactsCSVRequest, ImportContactsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportContactsCSV not implemented")
+}
+func (UnimplementedContactManagerServer) ListDeletedContacts(context.Context, *ListDeletedContactsRequest) (*ListDeletedContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedContacts not implemented")
+}
+func (UnimplementedContactManagerServer) RestoreContact(context.Context, *RestoreContactRequest) (*RestoreContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreContact not implemented")
+}
+func (UnimplementedContactManagerServer) PurgeContact(context.Context, *PurgeContactRequest) (*PurgeContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeContact not implemented")
+}
+func (UnimplementedContactManagerServer) ListContactRevisions(context.Context, *ListContactRevisionsRequest) (*ListContactRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContactRevisions not implemented")
+}
+func (UnimplementedContactManagerServer) RestoreContactRevision(context.Context, *RestoreContactRevisionRequest) (*RestoreContactRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreContactRevision not implemented")
+}
+func (UnimplementedContactManagerServer) FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicates not implemented")
+}
+func (UnimplementedContactManagerServer) MergeContacts(context.Context, *MergeContactsRequest) (*MergeContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeContacts not implemented")
+}
+func (UnimplementedContactManagerServer) UndoMergeContacts(context.Context, *UndoMergeContactsRequest) (*UndoMergeContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoMergeContacts not implemented")
+}
+func (UnimplementedContactManagerServer) GetUniquenessPolicy(context.Context, *GetUniquenessPolicyRequest) (*GetUniquenessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUniquenessPolicy not implemented")
+}
+func (UnimplementedContactManagerServer) SetUniquenessPolicy(context.Context, *SetUniquenessPolicyRequest) (*SetUniquenessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUniquenessPolicy not implemented")
+}
+func (UnimplementedContactManagerServer) BatchCreateContacts(context.Context, *BatchCreateContactsRequest) (*BatchCreateContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateContacts not implemented")
+}
+func (UnimplementedContactManagerServer) BatchDeleteContacts(context.Context, *BatchDeleteContactsRequest) (*BatchDeleteContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteContacts not implemented")
+}
+func (UnimplementedContactManagerServer) WatchContacts(*WatchContactsRequest, grpc.ServerStreamingServer[ContactEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchContacts not implemented")
+}
+func (UnimplementedContactManagerServer) SyncContacts(context.Context, *SyncContactsRequest) (*SyncContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncContacts not implemented")
+}
+func (UnimplementedContactManagerServer) ShareContact(context.Context, *ShareContactRequest) (*ShareContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareContact not implemented")
+}
+func (UnimplementedContactManagerServer) ShareGroup(context.Context, *ShareGroupRequest) (*ShareGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareGroup not implemented")
+}
+func (UnimplementedContactManagerServer) RemoveShare(context.Context, *RemoveShareRequest) (*RemoveShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveShare not implemented")
+}
+func (UnimplementedContactManagerServer) ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShares not implemented")
+}
+func (UnimplementedContactManagerServer) CreateAddressBook(context.Context, *CreateAddressBookRequest) (*CreateAddressBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAddressBook not implemented")
+}
+func (UnimplementedContactManagerServer) ListAddressBooks(context.Context, *ListAddressBooksRequest) (*ListAddressBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddressBooks not implemented")
+}
+func (UnimplementedContactManagerServer) ListAddressBookMembers(context.Context, *ListAddressBookMembersRequest) (*ListAddressBookMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddressBookMembers not implemented")
+}
+func (UnimplementedContactManagerServer) SetAddressBookMember(context.Context, *SetAddressBookMemberRequest) (*SetAddressBookMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddressBookMember not implemented")
+}
+func (UnimplementedContactManagerServer) RemoveAddressBookMember(context.Context, *RemoveAddressBookMemberRequest) (*RemoveAddressBookMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAddressBookMember not implemented")
+}
+func (UnimplementedContactManagerServer) mustEmbedUnimplementedContactManagerServer() {}
+func (UnimplementedContactManagerServer) testEmbeddedByValue()                        {}
+
+// UnsafeContactManagerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ContactManagerServer will
+// result in compilation errors.
+type UnsafeContactManagerServer interface {
+	mustEmbedUnimplementedContactManagerServer()
+}
+
+func RegisterContactManagerServer(s grpc.ServiceRegistrar, srv ContactManagerServer) {
+	// If the following call pancis, it indicates UnimplementedContactManagerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ContactManager_ServiceDesc, srv)
+}
+
+func _ContactManager_CreateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).CreateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_CreateContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).CreateContact(ctx, req.(*CreateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_GetContactByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).GetContactByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_GetContactByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).GetContactByName(ctx, req.(*GetContactByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_GetContactByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).GetContactByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_GetContactByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).GetContactByEmail(ctx, req.(*GetContactByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_GetContactByPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactByPhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).GetContactByPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_GetContactByPhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).GetContactByPhone(ctx, req.(*GetContactByPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_DeleteContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).DeleteContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_DeleteContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).DeleteContact(ctx, req.(*DeleteContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_UpdateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).UpdateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_UpdateContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).UpdateContact(ctx, req.(*UpdateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_ListContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).ListContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_ListContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).ListContacts(ctx, req.(*ListContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_SearchContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).SearchContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_SearchContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).SearchContacts(ctx, req.(*SearchContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_FuzzySearchContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuzzySearchContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).FuzzySearchContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_FuzzySearchContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).FuzzySearchContacts(ctx, req.(*FuzzySearchContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_RenameGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).RenameGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_RenameGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).RenameGroup(ctx, req.(*RenameGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_ListGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).ListGroups(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_AddGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).AddGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_AddGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).AddGroupMembers(ctx, req.(*AddGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_RemoveGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).RemoveGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_RemoveGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).RemoveGroupMembers(ctx, req.(*RemoveGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_AddContactTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddContactTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).AddContactTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_AddContactTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).AddContactTags(ctx, req.(*AddContactTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_RemoveContactTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveContactTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).RemoveContactTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_RemoveContactTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).RemoveContactTags(ctx, req.(*RemoveContactTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_ExportContacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportContactsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContactManagerServer).ExportContacts(m, &grpc.GenericServerStream[ExportContactsRequest, ExportContactsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContactManager_ExportContactsServer = grpc.ServerStreamingServer[ExportContactsResponse]
+
+func _ContactManager_ImportContacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ContactManagerServer).ImportContacts(&grpc.GenericServerStream[ImportContactsRequest, ImportContactsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContactManager_ImportContactsServer = grpc.ClientStreamingServer[ImportContactsRequest, ImportContactsResponse]
+
+func _ContactManager_ExportContactsCSV_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportContactsCSVRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContactManagerServer).ExportContactsCSV(m, &grpc.GenericServerStream[ExportContactsCSVRequest, ExportContactsCSVResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContactManager_ExportContactsCSVServer = grpc.ServerStreamingServer[ExportContactsCSVResponse]
+
+func _ContactManager_ImportContactsCSV_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ContactManagerServer).ImportContactsCSV(&grpc.GenericServerStream[ImportContactsCSVRequest, ImportContactsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContactManager_ImportContactsCSVServer = grpc.ClientStreamingServer[ImportContactsCSVRequest, ImportContactsResponse]
+
+func _ContactManager_ListDeletedContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).ListDeletedContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_ListDeletedContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).ListDeletedContacts(ctx, req.(*ListDeletedContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_RestoreContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).RestoreContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_RestoreContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).RestoreContact(ctx, req.(*RestoreContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_PurgeContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).PurgeContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_PurgeContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).PurgeContact(ctx, req.(*PurgeContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_ListContactRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).ListContactRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_ListContactRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).ListContactRevisions(ctx, req.(*ListContactRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_RestoreContactRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreContactRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).RestoreContactRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_RestoreContactRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).RestoreContactRevision(ctx, req.(*RestoreContactRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_FindDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).FindDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_FindDuplicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).FindDuplicates(ctx, req.(*FindDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_MergeContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).MergeContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_MergeContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).MergeContacts(ctx, req.(*MergeContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_UndoMergeContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoMergeContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).UndoMergeContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_UndoMergeContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).UndoMergeContacts(ctx, req.(*UndoMergeContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_GetUniquenessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUniquenessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).GetUniquenessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_GetUniquenessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).GetUniquenessPolicy(ctx, req.(*GetUniquenessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_SetUniquenessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUniquenessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).SetUniquenessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_SetUniquenessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).SetUniquenessPolicy(ctx, req.(*SetUniquenessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_BatchCreateContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).BatchCreateContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_BatchCreateContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).BatchCreateContacts(ctx, req.(*BatchCreateContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_BatchDeleteContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).BatchDeleteContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_BatchDeleteContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).BatchDeleteContacts(ctx, req.(*BatchDeleteContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_WatchContacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchContactsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContactManagerServer).WatchContacts(m, &grpc.GenericServerStream[WatchContactsRequest, ContactEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContactManager_WatchContactsServer = grpc.ServerStreamingServer[ContactEvent]
+
+func _ContactManager_SyncContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).SyncContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_SyncContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).SyncContacts(ctx, req.(*SyncContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_ShareContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).ShareContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_ShareContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).ShareContact(ctx, req.(*ShareContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_ShareGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).ShareGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_ShareGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).ShareGroup(ctx, req.(*ShareGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_RemoveShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).RemoveShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_RemoveShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).RemoveShare(ctx, req.(*RemoveShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_ListShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).ListShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_ListShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).ListShares(ctx, req.(*ListSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_CreateAddressBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAddressBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).CreateAddressBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_CreateAddressBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).CreateAddressBook(ctx, req.(*CreateAddressBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_ListAddressBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddressBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).ListAddressBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_ListAddressBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).ListAddressBooks(ctx, req.(*ListAddressBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_ListAddressBookMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddressBookMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).ListAddressBookMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_ListAddressBookMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).ListAddressBookMembers(ctx, req.(*ListAddressBookMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_SetAddressBookMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAddressBookMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).SetAddressBookMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_SetAddressBookMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).SetAddressBookMember(ctx, req.(*SetAddressBookMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactManager_RemoveAddressBookMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAddressBookMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactManagerServer).RemoveAddressBookMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactManager_RemoveAddressBookMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactManagerServer).RemoveAddressBookMember(ctx, req.(*RemoveAddressBookMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ContactManager_ServiceDesc is the grpc.ServiceDesc for ContactManager service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ContactManager_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ContactManager.ContactManager",
+	HandlerType: (*ContactManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateContact",
+			Handler:    _ContactManager_CreateContact_Handler,
+		},
+		{
+			MethodName: "GetContactByName",
+			Handler:    _ContactManager_GetContactByName_Handler,
+		},
+		{
+			MethodName: "GetContactByEmail",
+			Handler:    _ContactManager_GetContactByEmail_Handler,
+		},
+		{
+			MethodName: "GetContactByPhone",
+			Handler:    _ContactManager_GetContactByPhone_Handler,
+		},
+		{
+			MethodName: "DeleteContact",
+			Handler:    _ContactManager_DeleteContact_Handler,
+		},
+		{
+			MethodName: "UpdateContact",
+			Handler:    _ContactManager_UpdateContact_Handler,
+		},
+		{
+			MethodName: "ListContacts",
+			Handler:    _ContactManager_ListContacts_Handler,
+		},
+		{
+			MethodName: "SearchContacts",
+			Handler:    _ContactManager_SearchContacts_Handler,
+		},
+		{
+			MethodName: "FuzzySearchContacts",
+			Handler:    _ContactManager_FuzzySearchContacts_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _ContactManager_CreateGroup_Handler,
+		},
+		{
+			MethodName: "RenameGroup",
+			Handler:    _ContactManager_RenameGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _ContactManager_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _ContactManager_ListGroups_Handler,
+		},
+		{
+			MethodName: "AddGroupMembers",
+			Handler:    _ContactManager_AddGroupMembers_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMembers",
+			Handler:    _ContactManager_RemoveGroupMembers_Handler,
+		},
+		{
+			MethodName: "AddContactTags",
+			Handler:    _ContactManager_AddContactTags_Handler,
+		},
+		{
+			MethodName: "RemoveContactTags",
+			Handler:    _ContactManager_RemoveContactTags_Handler,
+		},
+		{
+			MethodName: "ListDeletedContacts",
+			Handler:    _ContactManager_ListDeletedContacts_Handler,
+		},
+		{
+			MethodName: "RestoreContact",
+			Handler:    _ContactManager_RestoreContact_Handler,
+		},
+		{
+			MethodName: "PurgeContact",
+			Handler:    _ContactManager_PurgeContact_Handler,
+		},
+		{
+			MethodName: "ListContactRevisions",
+			Handler:    _ContactManager_ListContactRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreContactRevision",
+			Handler:    _ContactManager_RestoreContactRevision_Handler,
+		},
+		{
+			MethodName: "FindDuplicates",
+			Handler:    _ContactManager_FindDuplicates_Handler,
+		},
+		{
+			MethodName: "MergeContacts",
+			Handler:    _ContactManager_MergeContacts_Handler,
+		},
+		{
+			MethodName: "UndoMergeContacts",
+			Handler:    _ContactManager_UndoMergeContacts_Handler,
+		},
+		{
+			MethodName: "GetUniquenessPolicy",
+			Handler:    _ContactManager_GetUniquenessPolicy_Handler,
+		},
+		{
+			MethodName: "SetUniquenessPolicy",
+			Handler:    _ContactManager_SetUniquenessPolicy_Handler,
+		},
+		{
+			MethodName: "BatchCreateContacts",
+			Handler:    _ContactManager_BatchCreateContacts_Handler,
+		},
+		{
+			MethodName: "BatchDeleteContacts",
+			Handler:    _ContactManager_BatchDeleteContacts_Handler,
+		},
+		{
+			MethodName: "SyncContacts",
+			Handler:    _ContactManager_SyncContacts_Handler,
+		},
+		{
+			MethodName: "ShareContact",
+			Handler:    _ContactManager_ShareContact_Handler,
+		},
+		{
+			MethodName: "ShareGroup",
+			Handler:    _ContactManager_ShareGroup_Handler,
+		},
+		{
+			MethodName: "RemoveShare",
+			Handler:    _ContactManager_RemoveShare_Handler,
+		},
+		{
+			MethodName: "ListShares",
+			Handler:    _ContactManager_ListShares_Handler,
+		},
+		{
+			MethodName: "CreateAddressBook",
+			Handler:    _ContactManager_CreateAddressBook_Handler,
+		},
+		{
+			MethodName: "ListAddressBooks",
+			Handler:    _ContactManager_ListAddressBooks_Handler,
+		},
+		{
+			MethodName: "ListAddressBookMembers",
+			Handler:    _ContactManager_ListAddressBookMembers_Handler,
+		},
+		{
+			MethodName: "SetAddressBookMember",
+			Handler:    _ContactManager_SetAddressBookMember_Handler,
+		},
+		{
+			MethodName: "RemoveAddressBookMember",
+			Handler:    _ContactManager_RemoveAddressBookMember_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportContacts",
+			Handler:       _ContactManager_ExportContacts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportContacts",
+			Handler:       _ContactManager_ImportContacts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportContactsCSV",
+			Handler:       _ContactManager_ExportContactsCSV_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportContactsCSV",
+			Handler:       _ContactManager_ImportContactsCSV_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchContacts",
+			Handler:       _ContactManager_WatchContacts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cm/cm.proto",
+}
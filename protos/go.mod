@@ -0,0 +1,3 @@
+module github.com/tendze/gRPC_ContactManager_Protos
+
+go 1.22.2
\ No newline at end of file